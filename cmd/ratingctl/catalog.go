@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+)
+
+// runIngestCatalog - загрузка проекции каталога из JSON Lines (по CatalogMessage на строку)
+// без Kafka: для локальной разработки и первичного наполнения.
+func runIngestCatalog(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("ingest-catalog", flag.ExitOnError)
+	file := fs.String("file", "", "catalog events, one JSON object per line")
+	_ = fs.Parse(args)
+
+	if *file == "" {
+		return fmt.Errorf("-file is required")
+	}
+
+	repo, err := e.repository()
+	if err != nil {
+		return err
+	}
+	validator, err := e.validator()
+	if err != nil {
+		return err
+	}
+	catalog := usecase.NewCatalogService(repo, validator, e.logger)
+
+	applied, rejected, err := ingestEvents(ctx, *file, catalog.HandleCatalogEvent)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("catalog events applied: %d, rejected: %d\n", applied, rejected)
+	return nil
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	kafka_rating "github.com/RozmiDan/gameReviewHubRating/internal/transport/kafka"
+)
+
+func runReplayDLQ(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("replay-dlq", flag.ExitOnError)
+	limit := fs.Int("limit", 0, "max messages to replay, 0 - until the DLQ is drained")
+	_ = fs.Parse(args)
+
+	n, err := kafka_rating.ReplayDLQ(ctx, e.cfg.Kafka, *limit, e.logger)
+	fmt.Printf("replayed %d messages from %s to %s\n", n, e.cfg.Kafka.TopicDLQ, e.cfg.Kafka.TopicRatings)
+	return err
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ingestEvents подаёт сообщения из JSON Lines в обработчик топика - замена Kafka для
+// локальной разработки. Ошибки отдельных строк печатаются и считаются, но не прерывают чтение.
+func ingestEvents[T any](ctx context.Context, path string, handle func(context.Context, T) error) (applied, rejected int, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+		var msg T
+		if err := json.Unmarshal(sc.Bytes(), &msg); err != nil {
+			fmt.Fprintf(os.Stderr, "%s:%d: %v\n", path, line, err)
+			rejected++
+			continue
+		}
+		if err := handle(ctx, msg); err != nil {
+			fmt.Fprintf(os.Stderr, "%s:%d: %v\n", path, line, err)
+			rejected++
+			continue
+		}
+		applied++
+	}
+
+	return applied, rejected, sc.Err()
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/bulk"
+	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+)
+
+func runExport(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	dataset := fs.String("dataset", "all", "all|ratings|game_ratings")
+	formatName := fs.String("format", "csv", "csv|jsonl|parquet")
+	out := fs.String("out", "", "output file for a single dataset (- for stdout) or directory for all; default: current directory")
+	from := fs.String("from", "", "ratings created at or after (RFC 3339 or YYYY-MM-DD)")
+	to := fs.String("to", "", "ratings created before (RFC 3339 or YYYY-MM-DD)")
+	games := fs.String("games", "", "comma-separated game ids")
+	_ = fs.Parse(args)
+
+	format, err := bulk.ParseFormat(*formatName)
+	if err != nil || format == bulk.FormatAuto || format == bulk.FormatJSON {
+		return fmt.Errorf("unsupported export format %q", *formatName)
+	}
+
+	filter := entity.ExportFilter{}
+	if *dataset != "all" {
+		filter.Datasets = []entity.Dataset{entity.Dataset(*dataset)}
+	}
+	if filter.From, err = parseTime(*from); err != nil {
+		return fmt.Errorf("-from: %w", err)
+	}
+	if filter.To, err = parseTime(*to); err != nil {
+		return fmt.Errorf("-to: %w", err)
+	}
+	if *games != "" {
+		for _, id := range strings.Split(*games, ",") {
+			filter.GameIDs = append(filter.GameIDs, strings.TrimSpace(id))
+		}
+	}
+
+	var files []*outFile
+	sink := bulk.NewSink(format, func(ds entity.Dataset) (io.WriteCloser, error) {
+		if len(filter.Datasets) == 1 && *out == "-" {
+			return nopCloser{os.Stdout}, nil
+		}
+
+		path := filepath.Join(*out, string(ds)+format.Ext())
+		if len(filter.Datasets) == 1 && *out != "" {
+			path = *out
+		}
+		f, err := createOutFile(path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+		return f, nil
+	})
+
+	repo, err := e.repository()
+	if err != nil {
+		return err
+	}
+	validator, err := e.validator()
+	if err != nil {
+		return err
+	}
+
+	if err := usecase.NewExportService(repo, validator, e.logger).Export(ctx, filter, sink); err != nil {
+		for _, f := range files {
+			f.abort()
+		}
+		return err
+	}
+
+	for _, f := range files {
+		fmt.Fprintf(os.Stderr, "wrote %s\n", f.path)
+	}
+	return nil
+}
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// outFile пишет во временный файл и переименовывает его при Close,
+// чтобы прерванная выгрузка не оставляла обрезанный файл под итоговым именем.
+type outFile struct {
+	*os.File
+	path string
+}
+
+func createOutFile(path string) (*outFile, error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	return &outFile{File: f, path: path}, nil
+}
+
+func (f *outFile) Close() error {
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), f.path)
+}
+
+func (f *outFile) abort() {
+	_ = f.File.Close()
+	_ = os.Remove(f.Name())
+}
+
+type nopCloser struct{ io.Writer }
+
+func (nopCloser) Close() error { return nil }
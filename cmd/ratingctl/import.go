@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/bulk"
+	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+)
+
+// _maxPrintedRejects - сколько отклонённых строк печатать, остальные только считаются.
+const _maxPrintedRejects = 100
+
+func runImport(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	file := fs.String("file", "", "file with ratings: JSON array, JSON Lines or CSV")
+	format := fs.String("format", "auto", "auto|json|jsonl|csv")
+	dryRun := fs.Bool("dry-run", false, "validate and merge in a transaction that is rolled back")
+	_ = fs.Parse(args)
+
+	if *file == "" {
+		return fmt.Errorf("-file is required")
+	}
+	return importFile(ctx, e, *file, *format, *dryRun)
+}
+
+// runSeed - импорт seed_data.json из корня репозитория.
+func runSeed(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("seed", flag.ExitOnError)
+	file := fs.String("file", "seed_data.json", "seed file")
+	dryRun := fs.Bool("dry-run", false, "validate without writing")
+	_ = fs.Parse(args)
+
+	return importFile(ctx, e, *file, "auto", *dryRun)
+}
+
+func importFile(ctx context.Context, e *env, path, formatName string, dryRun bool) error {
+	format, err := bulk.ParseFormat(formatName)
+	if err != nil {
+		return err
+	}
+	if format == bulk.FormatAuto {
+		format = bulk.FormatFromPath(path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dec, err := bulk.NewDecoder(f, format)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	repo, err := e.repository()
+	if err != nil {
+		return err
+	}
+	validator, err := e.validator()
+	if err != nil {
+		return err
+	}
+
+	importer, err := usecase.NewImportService(repo, validator, e.cfg.Embargo, e.logger)
+	if err != nil {
+		return err
+	}
+	res, err := importer.Import(ctx, dec, dryRun)
+	if err != nil {
+		return err
+	}
+
+	for i, rej := range res.Rejected {
+		if i == _maxPrintedRejects {
+			fmt.Fprintf(os.Stderr, "... and %d more rejected rows\n", len(res.Rejected)-i)
+			break
+		}
+		fmt.Fprintf(os.Stderr, "%s: %v\n", path, rej)
+	}
+
+	mode := "imported"
+	if res.DryRun {
+		mode = "dry run (rolled back)"
+	}
+	fmt.Printf("%s: %d rows, %d rejected, %d duplicates; %d inserted, %d updated, %d parked, %d games\n",
+		mode, res.Total, len(res.Rejected), res.Duplicates, res.Inserted, res.Updated, res.Parked, res.Games)
+
+	if len(res.Rejected) > 0 {
+		return fmt.Errorf("%d rows rejected", len(res.Rejected))
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// ratingctl - утилита обслуживания сервиса оценок. Читает тот же конфиг (CONFIG_PATH),
+// что и сервер, и работает через те же репозиторий и usecase'ы.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/metrics"
+	postgres_storage "github.com/RozmiDan/gameReviewHubRating/internal/storage/postgres"
+	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/logger"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/postgres"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+type command struct {
+	usage string
+	run   func(ctx context.Context, e *env, args []string) error
+}
+
+var commands = map[string]command{
+	"migrate":            {"migrate up|down|status|version", runMigrate},
+	"reconcile":          {"reconcile", runReconcile},
+	"recompute":          {"recompute -game <game_id>", runRecompute},
+	"delete-user":        {"delete-user -user <user_id>", runDeleteUser},
+	"distribution":       {"distribution -game <game_id>", runDistribution},
+	"replay-dlq":         {"replay-dlq [-limit N]", runReplayDLQ},
+	"export":             {"export [-dataset all|ratings|game_ratings] [-format csv|jsonl|parquet] [-out path] [-from t] [-to t] [-games id,...]", runExport},
+	"import":             {"import -file <path> [-format auto|json|jsonl|csv] [-dry-run]", runImport},
+	"seed":               {"seed [-file seed_data.json] [-dry-run]", runSeed},
+	"snapshot":           {"snapshot", runSnapshot},
+	"backfill-snapshots": {"backfill-snapshots [-granularity hour|day] [-replace]", runBackfillSnapshots},
+	"similarity":         {"similarity [-full]", runSimilarity},
+	"eval-recommender":   {"eval-recommender [-test-fraction 0.2] [-k 10] [-relevant 7] [-seed 1]", runEvalRecommender},
+	"normalize":          {"normalize [-method mean_center|zscore]", runNormalize},
+	"reweight":           {"reweight", runReweight},
+	"ingest-ownership":   {"ingest-ownership -file <events.jsonl>", runIngestOwnership},
+	"reverify":           {"reverify", runReverify},
+	"ingest-catalog":     {"ingest-catalog -file <events.jsonl>", runIngestCatalog},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	cfg := config.MustLoad()
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	e := &env{cfg: cfg, logger: logger.NewLogger(cfg.Env).With(zap.String("cmd", os.Args[1]))}
+	defer e.close()
+
+	if err := cmd.run(ctx, e, os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", os.Args[1], err)
+		e.close()
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stderr, "usage: CONFIG_PATH=<config.yaml> ratingctl <command> [flags]")
+	fmt.Fprintln(os.Stderr, "\ncommands:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n", commands[name].usage)
+	}
+}
+
+// env лениво открывает пул и собирает зависимости так же, как app.Run.
+type env struct {
+	cfg    *config.Config
+	logger *zap.Logger
+	pg     *postgres.Postgres
+	repo   *postgres_storage.RatingRepository
+}
+
+func (e *env) repository() (*postgres_storage.RatingRepository, error) {
+	if e.repo != nil {
+		return e.repo, nil
+	}
+
+	pg, err := postgres.New(e.cfg.PostgreURL.URL,
+		postgres.MaxPoolSize(int(e.cfg.PostgreURL.PgPoolMax)),
+		postgres.ConnAttempts(e.cfg.PostgreURL.ConnAttempts),
+		postgres.ConnTimeout(e.cfg.PostgreURL.ConnTimeout),
+	)
+	if err != nil {
+		return nil, err
+	}
+	e.pg = pg
+	e.repo = postgres_storage.New(pg, metrics.New(prometheus.NewRegistry()), e.logger)
+
+	return e.repo, nil
+}
+
+func (e *env) validator() (*usecase.Validator, error) {
+	return usecase.NewValidator(e.cfg.Validation)
+}
+
+func (e *env) close() {
+	if e.pg != nil {
+		e.pg.Close()
+		e.pg = nil
+	}
+	_ = e.logger.Sync()
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/RozmiDan/gameReviewHubRating/db"
+)
+
+func runMigrate(_ context.Context, e *env, args []string) error {
+	if len(args) == 0 {
+		return errors.New("expected up, down, status or version")
+	}
+
+	switch args[0] {
+	case "up", "down", "status", "version", "redo":
+	default:
+		return errors.New("expected up, down, status or version")
+	}
+
+	sqlDB := db.OpenDB(e.cfg)
+	defer sqlDB.Close()
+
+	return db.Migrate(sqlDB, args[0], args[1:]...)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+)
+
+// runNormalize - внеочередной пересчёт нормализованного рейтинга, например после смены метода.
+func runNormalize(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("normalize", flag.ExitOnError)
+	method := fs.String("method", e.cfg.Normalize.Method, "mean_center|zscore")
+	_ = fs.Parse(args)
+
+	repo, err := e.repository()
+	if err != nil {
+		return err
+	}
+
+	cfg := e.cfg.Normalize
+	cfg.Method = *method
+	normalize, err := usecase.NewNormalizeService(repo, cfg, e.logger)
+	if err != nil {
+		return err
+	}
+
+	if err := normalize.Recompute(ctx); err != nil {
+		return err
+	}
+
+	fmt.Printf("normalized ratings recomputed (%s)\n", cfg.Method)
+	return nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+)
+
+// runIngestOwnership - замена топика владения для локальной разработки: события из JSON
+// Lines (по OwnershipMessage на строку) идут через тот же обработчик, что и из Kafka.
+func runIngestOwnership(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("ingest-ownership", flag.ExitOnError)
+	file := fs.String("file", "", "ownership events, one JSON object per line")
+	_ = fs.Parse(args)
+
+	if *file == "" {
+		return fmt.Errorf("-file is required")
+	}
+
+	repo, err := e.repository()
+	if err != nil {
+		return err
+	}
+	validator, err := e.validator()
+	if err != nil {
+		return err
+	}
+	ownership := usecase.NewOwnershipService(repo, validator, e.cfg.Ownership, e.logger)
+
+	applied, rejected, err := ingestEvents(ctx, *file, ownership.HandleOwnershipEvent)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("ownership events applied: %d, rejected: %d\n", applied, rejected)
+	return nil
+}
+
+// runReverify - пересчёт признака проверенного игрока после смены ownership.min_playtime.
+func runReverify(ctx context.Context, e *env, _ []string) error {
+	repo, err := e.repository()
+	if err != nil {
+		return err
+	}
+	validator, err := e.validator()
+	if err != nil {
+		return err
+	}
+
+	changed, err := usecase.NewOwnershipService(repo, validator, e.cfg.Ownership, e.logger).Reverify(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("players reverified: %d changed\n", changed)
+	return nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+)
+
+func adminService(e *env) (adminUseCase, error) {
+	repo, err := e.repository()
+	if err != nil {
+		return nil, err
+	}
+	validator, err := e.validator()
+	if err != nil {
+		return nil, err
+	}
+	return usecase.NewAdminService(repo, validator, e.logger), nil
+}
+
+func runReconcile(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("reconcile", flag.ExitOnError)
+	_ = fs.Parse(args)
+
+	admin, err := adminService(e)
+	if err != nil {
+		return err
+	}
+
+	fixed, err := admin.ReconcileAggregates(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("reconciled: %d game aggregates fixed\n", fixed)
+	return nil
+}
+
+func runRecompute(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("recompute", flag.ExitOnError)
+	gameID := fs.String("game", "", "game id")
+	_ = fs.Parse(args)
+
+	admin, err := adminService(e)
+	if err != nil {
+		return err
+	}
+
+	if err := admin.RecomputeGame(ctx, *gameID); err != nil {
+		return err
+	}
+
+	fmt.Printf("game %s recomputed\n", *gameID)
+	return nil
+}
+
+func runDeleteUser(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("delete-user", flag.ExitOnError)
+	userID := fs.String("user", "", "user id")
+	_ = fs.Parse(args)
+
+	admin, err := adminService(e)
+	if err != nil {
+		return err
+	}
+
+	games, err := admin.DeleteUserRatings(ctx, *userID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("deleted %d ratings of user %s\n", len(games), *userID)
+	if len(games) > 0 {
+		fmt.Printf("recomputed games: %s\n", strings.Join(games, ", "))
+	}
+	return nil
+}
+
+func runDistribution(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("distribution", flag.ExitOnError)
+	gameID := fs.String("game", "", "game id")
+	_ = fs.Parse(args)
+
+	admin, err := adminService(e)
+	if err != nil {
+		return err
+	}
+
+	dist, err := admin.GetDistribution(ctx, *gameID)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "score\tcount\tshare\t\n")
+	for _, b := range dist.Buckets {
+		share := float64(b.Count) / float64(dist.Total)
+		fmt.Fprintf(w, "%d\t%d\t%5.1f%%\t%s\n", b.Score, b.Count, share*100, strings.Repeat("#", int(share*40)))
+	}
+	fmt.Fprintf(w, "total\t%d\t\t\n", dist.Total)
+	return w.Flush()
+}
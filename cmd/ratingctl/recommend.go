@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+)
+
+// runEvalRecommender - офлайн-оценка RecommendGames на отложенной части текущих оценок.
+func runEvalRecommender(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("eval-recommender", flag.ExitOnError)
+	testFraction := fs.Float64("test-fraction", 0.2, "share of each user's ratings held out for testing")
+	k := fs.Int("k", 10, "recommendation list length for precision@k")
+	relevant := fs.Float64("relevant", 7, "held-out score (1-10) that counts as a hit")
+	seed := fs.Int64("seed", 1, "random seed for the split")
+	_ = fs.Parse(args)
+
+	repo, err := e.repository()
+	if err != nil {
+		return err
+	}
+	validator, err := e.validator()
+	if err != nil {
+		return err
+	}
+	recommender, err := usecase.NewRecommendService(repo, validator, e.cfg.Similarity, e.cfg.Recommend, e.logger)
+	if err != nil {
+		return err
+	}
+
+	res, err := recommender.Evaluate(ctx, entity.EvalOptions{
+		TestFraction:  *testFraction,
+		K:             *k,
+		RelevantScore: *relevant,
+		Seed:          *seed,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("train: %d ratings, test: %d ratings, users with hits: %d\n\n", res.TrainRatings, res.TestRatings, res.Users)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "model\tRMSE\tprecision@%d\tCF coverage\n", res.K)
+	fmt.Fprintf(w, "item-cf\t%.4f\t%.4f\t%.1f%%\n", res.RMSE, res.PrecisionAtK, res.CFCoverage*100)
+	fmt.Fprintf(w, "popularity\t%.4f\t%.4f\t-\n", res.BaselineRMSE, res.BaselinePrecisionAtK)
+	return w.Flush()
+}
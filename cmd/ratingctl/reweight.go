@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+)
+
+// runReweight - внеочередной пересчёт весов оценщиков, например после разметки
+// накрутки или смены параметров модели в конфиге.
+func runReweight(ctx context.Context, e *env, _ []string) error {
+	repo, err := e.repository()
+	if err != nil {
+		return err
+	}
+
+	validator, err := e.validator()
+	if err != nil {
+		return err
+	}
+
+	if err := usecase.NewReputationService(repo, validator, e.cfg.Reputation, e.logger).Recalculate(ctx); err != nil {
+		return err
+	}
+
+	fmt.Println("rater weights recalculated")
+	return nil
+}
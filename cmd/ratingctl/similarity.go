@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+)
+
+// runSimilarity - пересчёт похожих игр: по умолчанию только изменившихся, -full - всех с нуля.
+func runSimilarity(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("similarity", flag.ExitOnError)
+	full := fs.Bool("full", false, "rebuild neighbours of all games from scratch")
+	_ = fs.Parse(args)
+
+	repo, err := e.repository()
+	if err != nil {
+		return err
+	}
+	validator, err := e.validator()
+	if err != nil {
+		return err
+	}
+	similarity, err := usecase.NewSimilarityService(repo, validator, e.cfg.Similarity, e.logger)
+	if err != nil {
+		return err
+	}
+
+	if !*full {
+		if err := similarity.Refresh(ctx); err != nil {
+			return err
+		}
+		fmt.Println("similarities refreshed")
+		return nil
+	}
+
+	n, err := similarity.Rebuild(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("similarities rebuilt: %d neighbour pairs written\n", n)
+	return nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+)
+
+func snapshotService(e *env) (snapshotUseCase, error) {
+	repo, err := e.repository()
+	if err != nil {
+		return nil, err
+	}
+	validator, err := e.validator()
+	if err != nil {
+		return nil, err
+	}
+	return usecase.NewSnapshotService(repo, validator, e.cfg.Snapshots, e.logger), nil
+}
+
+// runSnapshot - внеочередной снимок, как его делает сервер по расписанию.
+func runSnapshot(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("snapshot", flag.ExitOnError)
+	_ = fs.Parse(args)
+
+	snapshots, err := snapshotService(e)
+	if err != nil {
+		return err
+	}
+
+	if err := snapshots.TakeSnapshots(ctx); err != nil {
+		return err
+	}
+
+	fmt.Println("snapshots taken")
+	return nil
+}
+
+func runBackfillSnapshots(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("backfill-snapshots", flag.ExitOnError)
+	granularity := fs.String("granularity", "day", "hour|day")
+	replace := fs.Bool("replace", false, "overwrite snapshots that already exist")
+	_ = fs.Parse(args)
+
+	g, err := entity.ParseGranularity(*granularity)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := snapshotService(e)
+	if err != nil {
+		return err
+	}
+
+	n, err := snapshots.Backfill(ctx, g, *replace)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("backfilled: %d %s snapshots written\n", n, g)
+	return nil
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"context"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+type adminUseCase interface {
+	ReconcileAggregates(ctx context.Context) (int64, error)
+	RecomputeGame(ctx context.Context, gameID string) error
+	DeleteUserRatings(ctx context.Context, userID string) ([]string, error)
+	GetDistribution(ctx context.Context, gameID string) (entity.Distribution, error)
+}
+
+type snapshotUseCase interface {
+	TakeSnapshots(ctx context.Context) error
+	Backfill(ctx context.Context, g entity.Granularity, replace bool) (int64, error)
+}
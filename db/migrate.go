@@ -1,7 +1,9 @@
 package db
 
 import (
+	"database/sql"
 	"embed"
+	"fmt"
 	"os"
 
 	"github.com/RozmiDan/gameReviewHubRating/internal/config"
@@ -16,7 +18,19 @@ import (
 var embedMigrations embed.FS
 
 func SetupPostgres(cfg *config.Config, logger *zap.Logger) {
+	db := OpenDB(cfg)
+	defer db.Close()
 
+	if err := Migrate(db, "up"); err != nil {
+		logger.Error("can't setup migrations",
+			zap.Error(err),
+		)
+		os.Exit(1)
+	}
+}
+
+// OpenDB открывает database/sql соединение для goose.
+func OpenDB(cfg *config.Config) *sql.DB {
 	pgxConf := pgx.ConnConfig{
 		Host:     cfg.PostgreURL.Host,
 		Port:     cfg.PostgreURL.Port,
@@ -25,19 +39,15 @@ func SetupPostgres(cfg *config.Config, logger *zap.Logger) {
 		Password: cfg.PostgreURL.Password,
 	}
 
+	return stdlib.OpenDB(pgxConf)
+}
+
+// Migrate выполняет команду goose: up, down, status и т.д.
+func Migrate(db *sql.DB, command string, args ...string) error {
 	goose.SetBaseFS(embedMigrations)
 	if err := goose.SetDialect("postgres"); err != nil {
-		logger.Error("can't set dialect in goose",
-			zap.Error(err),
-		)
-		os.Exit(1)
+		return fmt.Errorf("can't set dialect in goose: %w", err)
 	}
 
-	db := stdlib.OpenDB(pgxConf)
-	if err := goose.Up(db, "migrations"); err != nil {
-		logger.Error("can't setup migrations",
-			zap.Error(err),
-		)
-		os.Exit(1)
-	}
+	return goose.Run(command, db, "migrations", args...)
 }
@@ -0,0 +1,35 @@
+-- +goose Up
+ALTER TABLE ratings DROP CONSTRAINT IF EXISTS ratings_rating_check;
+
+ALTER TABLE ratings
+  ADD COLUMN scale TEXT         NOT NULL DEFAULT 'ten',
+  ADD COLUMN score NUMERIC(4,2);
+
+UPDATE ratings SET score = rating;
+
+ALTER TABLE ratings
+  ALTER COLUMN score SET NOT NULL,
+  ADD CONSTRAINT ratings_score_check CHECK (score BETWEEN 1 AND 10),
+  ADD CONSTRAINT ratings_scale_check CHECK (
+    (scale = 'stars5'  AND rating BETWEEN 1 AND 5)   OR
+    (scale = 'ten'     AND rating BETWEEN 1 AND 10)  OR
+    (scale = 'hundred' AND rating BETWEEN 1 AND 100) OR
+    (scale = 'thumbs'  AND rating BETWEEN 0 AND 1)
+  );
+
+ALTER TABLE game_ratings ALTER COLUMN ratings_sum TYPE NUMERIC(14,2);
+
+-- +goose Down
+ALTER TABLE game_ratings ALTER COLUMN ratings_sum TYPE BIGINT USING ROUND(ratings_sum);
+
+-- проверки шкалы снимаются до UPDATE: значения stars5/thumbs/hundred их бы нарушили
+ALTER TABLE ratings
+  DROP CONSTRAINT IF EXISTS ratings_scale_check,
+  DROP CONSTRAINT IF EXISTS ratings_score_check;
+
+UPDATE ratings SET rating = ROUND(score);
+
+ALTER TABLE ratings
+  DROP COLUMN IF EXISTS score,
+  DROP COLUMN IF EXISTS scale,
+  ADD CONSTRAINT ratings_rating_check CHECK (rating BETWEEN 1 AND 10);
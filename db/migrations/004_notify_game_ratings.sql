@@ -0,0 +1,39 @@
+-- +goose Up
+-- Триггер отложенный: срабатывает на COMMIT и перечитывает строку, поэтому подписчики
+-- получают итоговые значения, а одинаковые уведомления одной транзакции Postgres схлопывает.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_game_rating() RETURNS trigger AS $$
+DECLARE
+  gid UUID;
+  avg NUMERIC(4,2);
+  cnt BIGINT;
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    gid := OLD.game_id;
+  ELSE
+    gid := NEW.game_id;
+  END IF;
+
+  SELECT average_rating, ratings_count INTO avg, cnt
+  FROM game_ratings
+  WHERE game_id = gid;
+
+  PERFORM pg_notify('game_ratings', json_build_object(
+    'game_id',        gid,
+    'average_rating', COALESCE(avg, 0),
+    'ratings_count',  COALESCE(cnt, 0)
+  )::text);
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE CONSTRAINT TRIGGER game_ratings_notify
+  AFTER INSERT OR UPDATE OR DELETE ON game_ratings
+  DEFERRABLE INITIALLY DEFERRED
+  FOR EACH ROW EXECUTE FUNCTION notify_game_rating();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS game_ratings_notify ON game_ratings;
+DROP FUNCTION IF EXISTS notify_game_rating();
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Состояние агрегата игры на конец интервала: bucket_start - начало часа/дня в UTC.
+-- distribution[k] - число оценок, чей score округляется до k (1..10).
+CREATE TABLE IF NOT EXISTS game_rating_snapshots (
+  game_id        UUID         NOT NULL,
+  granularity    TEXT         NOT NULL CHECK (granularity IN ('hour', 'day')),
+  bucket_start   TIMESTAMPTZ  NOT NULL,
+  ratings_count  BIGINT       NOT NULL,
+  ratings_sum    NUMERIC(14,2) NOT NULL,
+  average_rating NUMERIC(4,2),
+  distribution   BIGINT[]     NOT NULL,
+  taken_at       TIMESTAMPTZ  NOT NULL DEFAULT now(),
+  PRIMARY KEY (game_id, granularity, bucket_start)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS game_rating_snapshots;
@@ -0,0 +1,46 @@
+-- +goose Up
+-- Top-K похожих игр для каждой игры (item-item CF). Строки направленные: у игры A
+-- в соседях может быть B, а у B - не быть A, если у B соседи ближе.
+CREATE TABLE IF NOT EXISTS game_similarities (
+  game_id     UUID             NOT NULL,
+  neighbor_id UUID             NOT NULL,
+  similarity  DOUBLE PRECISION NOT NULL,
+  co_raters   INT              NOT NULL,
+  computed_at TIMESTAMPTZ      NOT NULL DEFAULT now(),
+  PRIMARY KEY (game_id, neighbor_id)
+);
+
+CREATE INDEX IF NOT EXISTS game_similarities_rank_idx
+  ON game_similarities (game_id, similarity DESC);
+
+-- Игры, чьи оценки менялись с последнего пересчёта похожести.
+CREATE TABLE IF NOT EXISTS game_similarity_dirty (
+  game_id   UUID        PRIMARY KEY,
+  marked_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION mark_similarity_dirty() RETURNS trigger AS $$
+BEGIN
+  INSERT INTO game_similarity_dirty(game_id)
+  VALUES (CASE WHEN TG_OP = 'DELETE' THEN OLD.game_id ELSE NEW.game_id END)
+  ON CONFLICT (game_id) DO NOTHING;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER ratings_mark_similarity_dirty
+  AFTER INSERT OR UPDATE OF score OR DELETE ON ratings
+  FOR EACH ROW EXECUTE FUNCTION mark_similarity_dirty();
+
+-- уже накопленные оценки попадут в первый пересчёт
+INSERT INTO game_similarity_dirty(game_id)
+SELECT DISTINCT game_id FROM ratings
+ON CONFLICT (game_id) DO NOTHING;
+
+-- +goose Down
+DROP TRIGGER IF EXISTS ratings_mark_similarity_dirty ON ratings;
+DROP FUNCTION IF EXISTS mark_similarity_dirty();
+DROP TABLE IF EXISTS game_similarity_dirty;
+DROP TABLE IF EXISTS game_similarities;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Рейтинг игры с поправкой на то, кто как щедро ставит: оценки пересчитываются
+-- относительно средней (и разброса) каждого пользователя и возвращаются на шкалу 1-10.
+-- Пересчитывается периодически целиком, game_ratings не трогает.
+CREATE TABLE IF NOT EXISTS game_normalized_ratings (
+  game_id           UUID         PRIMARY KEY,
+  normalized_rating NUMERIC(4,2) NOT NULL,
+  ratings_count     BIGINT       NOT NULL,
+  method            TEXT         NOT NULL,
+  computed_at       TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS game_normalized_ratings_rank_idx
+  ON game_normalized_ratings (normalized_rating DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS game_normalized_ratings;
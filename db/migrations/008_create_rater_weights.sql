@@ -0,0 +1,96 @@
+-- +goose Up
+-- Что известно о пользователе из потока событий аккаунтов.
+CREATE TABLE IF NOT EXISTS user_profiles (
+  user_id       UUID        PRIMARY KEY,
+  registered_at TIMESTAMPTZ,
+  flagged       BOOLEAN     NOT NULL DEFAULT false,
+  -- сколько раз пользователя помечали как участника аномалий, включая текущую пометку
+  flag_count    INT         NOT NULL DEFAULT 0,
+  flag_reason   TEXT,
+  updated_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Вес оценок пользователя в взвешенном агрегате, 0..1.
+CREATE TABLE IF NOT EXISTS rater_weights (
+  user_id          UUID             PRIMARY KEY,
+  weight           DOUBLE PRECISION NOT NULL,
+  age_factor       DOUBLE PRECISION NOT NULL,
+  history_factor   DOUBLE PRECISION NOT NULL,
+  agreement_factor DOUBLE PRECISION NOT NULL,
+  computed_at      TIMESTAMPTZ      NOT NULL DEFAULT now()
+);
+
+-- Вес пользователей, которых ещё не оценивали (новых): одна строка.
+CREATE TABLE IF NOT EXISTS rater_weight_default (
+  id     BOOLEAN          PRIMARY KEY DEFAULT true CHECK (id),
+  weight DOUBLE PRECISION NOT NULL
+);
+INSERT INTO rater_weight_default(weight) VALUES (1) ON CONFLICT DO NOTHING;
+
+ALTER TABLE game_ratings
+  ADD COLUMN IF NOT EXISTS weighted_sum     NUMERIC(18,4) NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS weight_total     NUMERIC(18,4) NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS weighted_average NUMERIC(4,2);
+
+-- пока весов нет, у всех вес 1
+UPDATE game_ratings
+SET weighted_sum = ratings_sum, weight_total = ratings_count, weighted_average = average_rating;
+
+-- Взвешенный агрегат ведёт триггер, а не код записи: так его не обойдут ни SubmitRating,
+-- ни импорт, ни админские пересчёты. Вес берётся текущий; когда веса пересчитываются,
+-- затронутые игры пересчитываются целиком (RecalculateWeightsRepo).
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION rater_weight(uid UUID) RETURNS NUMERIC AS $$
+  SELECT COALESCE(
+    (SELECT weight FROM rater_weights WHERE user_id = uid),
+    (SELECT weight FROM rater_weight_default),
+    1)::numeric;
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION apply_rater_weight() RETURNS trigger AS $$
+DECLARE
+  gid    UUID;
+  dsum   NUMERIC;
+  dtotal NUMERIC;
+BEGIN
+  IF TG_OP = 'INSERT' THEN
+    gid := NEW.game_id;
+    dtotal := rater_weight(NEW.user_id);
+    dsum := dtotal * NEW.score;
+  ELSIF TG_OP = 'UPDATE' THEN
+    gid := NEW.game_id;
+    dtotal := 0;
+    dsum := rater_weight(NEW.user_id) * (NEW.score - OLD.score);
+  ELSE
+    gid := OLD.game_id;
+    dtotal := -rater_weight(OLD.user_id);
+    dsum := dtotal * OLD.score;
+  END IF;
+
+  INSERT INTO game_ratings(game_id, weighted_sum, weight_total, weighted_average)
+  VALUES (gid, dsum, dtotal, ROUND(dsum / NULLIF(dtotal, 0), 2))
+  ON CONFLICT (game_id) DO UPDATE
+    SET weighted_sum     = game_ratings.weighted_sum + EXCLUDED.weighted_sum,
+        weight_total     = game_ratings.weight_total + EXCLUDED.weight_total,
+        weighted_average = ROUND((game_ratings.weighted_sum + EXCLUDED.weighted_sum)
+                                 / NULLIF(game_ratings.weight_total + EXCLUDED.weight_total, 0), 2);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER ratings_apply_rater_weight
+  AFTER INSERT OR UPDATE OF score OR DELETE ON ratings
+  FOR EACH ROW EXECUTE FUNCTION apply_rater_weight();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS ratings_apply_rater_weight ON ratings;
+DROP FUNCTION IF EXISTS apply_rater_weight();
+DROP FUNCTION IF EXISTS rater_weight(UUID);
+ALTER TABLE game_ratings
+  DROP COLUMN IF EXISTS weighted_average,
+  DROP COLUMN IF EXISTS weight_total,
+  DROP COLUMN IF EXISTS weighted_sum;
+DROP TABLE IF EXISTS rater_weight_default;
+DROP TABLE IF EXISTS rater_weights;
+DROP TABLE IF EXISTS user_profiles;
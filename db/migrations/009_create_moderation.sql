@@ -0,0 +1,39 @@
+-- +goose Up
+-- Журнал модерации: каждое скрытие/возврат оценок с модератором и причиной.
+CREATE TABLE IF NOT EXISTS moderation_actions (
+  id          BIGSERIAL   PRIMARY KEY,
+  action      TEXT        NOT NULL CHECK (action IN ('hide', 'unhide')),
+  moderator   TEXT        NOT NULL,
+  reason      TEXT        NOT NULL,
+  -- что затронуто: оценки user_id, только игры game_id (NULL - всех игр),
+  -- поставленные в [window_from, window_to) (NULL - без границы)
+  user_id     UUID        NOT NULL,
+  game_id     UUID,
+  window_from TIMESTAMPTZ,
+  window_to   TIMESTAMPTZ,
+  affected    INT         NOT NULL DEFAULT 0,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS moderation_actions_user_idx ON moderation_actions (user_id, id DESC);
+CREATE INDEX IF NOT EXISTS moderation_actions_game_idx ON moderation_actions (game_id, id DESC);
+
+-- Скрытые оценки переносятся из ratings целиком, поэтому все агрегаты, снимки, соседи
+-- и рекомендации, которые читают ratings, их просто не видят.
+CREATE TABLE IF NOT EXISTS hidden_ratings (
+  user_id    UUID         NOT NULL,
+  game_id    UUID         NOT NULL,
+  rating     SMALLINT     NOT NULL,
+  scale      TEXT         NOT NULL,
+  score      NUMERIC(4,2) NOT NULL,
+  created_at TIMESTAMPTZ  NOT NULL,
+  action_id  BIGINT       NOT NULL REFERENCES moderation_actions (id),
+  hidden_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, game_id)
+);
+
+CREATE INDEX IF NOT EXISTS hidden_ratings_game_idx ON hidden_ratings (game_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS hidden_ratings;
+DROP TABLE IF EXISTS moderation_actions;
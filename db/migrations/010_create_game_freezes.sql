@@ -0,0 +1,86 @@
+-- +goose Up
+-- Замороженные игры: пока запись действует, публичный рейтинг отдаётся из снимка,
+-- сделанного при заморозке, а game_ratings продолжает обновляться как обычно.
+-- Снять заморозку - удалить запись: накопленные изменения сразу видны.
+CREATE TABLE IF NOT EXISTS game_freezes (
+  game_id           UUID         PRIMARY KEY,
+  frozen_at         TIMESTAMPTZ  NOT NULL DEFAULT now(),
+  -- NULL - до ручного снятия
+  expires_at        TIMESTAMPTZ,
+  moderator         TEXT         NOT NULL,
+  reason            TEXT         NOT NULL,
+  average_rating    NUMERIC(4,2),
+  ratings_count     BIGINT       NOT NULL DEFAULT 0,
+  normalized_rating NUMERIC(4,2),
+  weighted_average  NUMERIC(4,2)
+);
+
+CREATE INDEX IF NOT EXISTS game_freezes_expires_idx ON game_freezes (expires_at) WHERE expires_at IS NOT NULL;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_game_rating() RETURNS trigger AS $$
+DECLARE
+  gid UUID;
+  avg NUMERIC(4,2);
+  cnt BIGINT;
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    gid := OLD.game_id;
+  ELSE
+    gid := NEW.game_id;
+  END IF;
+
+  -- публичный рейтинг замороженной игры не меняется; при снятии заморозки строку
+  -- game_ratings трогают, и подписчики получают накопленное
+  IF EXISTS (
+    SELECT 1 FROM game_freezes
+    WHERE game_id = gid AND (expires_at IS NULL OR expires_at > now())
+  ) THEN
+    RETURN NULL;
+  END IF;
+
+  SELECT average_rating, ratings_count INTO avg, cnt
+  FROM game_ratings
+  WHERE game_id = gid;
+
+  PERFORM pg_notify('game_ratings', json_build_object(
+    'game_id',        gid,
+    'average_rating', COALESCE(avg, 0),
+    'ratings_count',  COALESCE(cnt, 0)
+  )::text);
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_game_rating() RETURNS trigger AS $$
+DECLARE
+  gid UUID;
+  avg NUMERIC(4,2);
+  cnt BIGINT;
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    gid := OLD.game_id;
+  ELSE
+    gid := NEW.game_id;
+  END IF;
+
+  SELECT average_rating, ratings_count INTO avg, cnt
+  FROM game_ratings
+  WHERE game_id = gid;
+
+  PERFORM pg_notify('game_ratings', json_build_object(
+    'game_id',        gid,
+    'average_rating', COALESCE(avg, 0),
+    'ratings_count',  COALESCE(cnt, 0)
+  )::text);
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TABLE IF EXISTS game_freezes;
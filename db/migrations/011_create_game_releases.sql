@@ -0,0 +1,37 @@
+-- +goose Up
+-- Даты выхода игр: до release_at оценки не попадают в агрегаты. policy - что делать
+-- с такой оценкой: 'reject' - отклонить, 'park' - отложить до выхода; NULL - как в конфиге.
+CREATE TABLE IF NOT EXISTS game_releases (
+  game_id    UUID        PRIMARY KEY,
+  release_at TIMESTAMPTZ NOT NULL,
+  policy     TEXT        CHECK (policy IN ('reject', 'park')),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Отложенные оценки; после выхода игры переносятся в ratings (ReleaseParkedRepo).
+-- Повторная оценка до выхода перезаписывает отложенную.
+CREATE TABLE IF NOT EXISTS parked_ratings (
+  user_id    UUID         NOT NULL,
+  game_id    UUID         NOT NULL,
+  rating     SMALLINT     NOT NULL,
+  scale      TEXT         NOT NULL,
+  score      NUMERIC(4,2) NOT NULL,
+  created_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, game_id)
+);
+
+CREATE INDEX IF NOT EXISTS parked_ratings_game_idx ON parked_ratings (game_id);
+
+-- Пользователи (пресса, обозреватели), чьи оценки принимаются до выхода игры.
+-- Список ведут администраторы через RatingAdminService; роли от клиента не учитываются.
+CREATE TABLE IF NOT EXISTS embargo_exemptions (
+  user_id    UUID        PRIMARY KEY,
+  granted_by TEXT        NOT NULL,
+  reason     TEXT        NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS embargo_exemptions;
+DROP TABLE IF EXISTS parked_ratings;
+DROP TABLE IF EXISTS game_releases;
@@ -0,0 +1,111 @@
+-- +goose Up
+-- Владение и наигранное время из потока событий магазина/лаунчера. verified - оценка
+-- пользователя этой игры считается оценкой игрока: owned и не меньше
+-- ownership.min_playtime наиграно (порог применяется при записи, см. ReverifyRepo).
+CREATE TABLE IF NOT EXISTS player_games (
+  user_id          UUID        NOT NULL,
+  game_id          UUID        NOT NULL,
+  owned            BOOLEAN     NOT NULL DEFAULT false,
+  -- время последнего события владения: более старые события owned не меняют
+  owned_event_at   TIMESTAMPTZ,
+  playtime_minutes BIGINT      NOT NULL DEFAULT 0,
+  verified         BOOLEAN     NOT NULL DEFAULT false,
+  updated_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, game_id)
+);
+
+ALTER TABLE game_ratings
+  ADD COLUMN IF NOT EXISTS verified_count   BIGINT        NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS verified_sum     NUMERIC(14,2) NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS verified_average NUMERIC(4,2);
+
+CREATE INDEX IF NOT EXISTS game_ratings_verified_rank_idx
+  ON game_ratings (verified_average DESC NULLS LAST);
+
+ALTER TABLE game_freezes
+  ADD COLUMN IF NOT EXISTS verified_average NUMERIC(4,2),
+  ADD COLUMN IF NOT EXISTS verified_count   BIGINT NOT NULL DEFAULT 0;
+
+-- Агрегат по проверенным игрокам ведут два триггера: на ratings (оценка проверенного
+-- игрока появилась, изменилась, пропала) и на player_games (игрок стал или перестал
+-- быть проверенным при уже стоящей оценке).
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION add_verified_delta(gid UUID, dcount BIGINT, dsum NUMERIC) RETURNS void AS $$
+  INSERT INTO game_ratings(game_id, verified_count, verified_sum, verified_average)
+  VALUES (gid, dcount, dsum, ROUND(dsum / NULLIF(dcount, 0), 2))
+  ON CONFLICT (game_id) DO UPDATE
+    SET verified_count   = game_ratings.verified_count + EXCLUDED.verified_count,
+        verified_sum     = game_ratings.verified_sum + EXCLUDED.verified_sum,
+        verified_average = ROUND((game_ratings.verified_sum + EXCLUDED.verified_sum)
+                                 / NULLIF(game_ratings.verified_count + EXCLUDED.verified_count, 0), 2);
+$$ LANGUAGE sql;
+
+CREATE OR REPLACE FUNCTION apply_verified_rating() RETURNS trigger AS $$
+BEGIN
+  IF TG_OP IN ('UPDATE', 'DELETE') AND EXISTS (
+    SELECT 1 FROM player_games WHERE user_id = OLD.user_id AND game_id = OLD.game_id AND verified
+  ) THEN
+    PERFORM add_verified_delta(OLD.game_id, -1, -OLD.score);
+  END IF;
+  IF TG_OP IN ('INSERT', 'UPDATE') AND EXISTS (
+    SELECT 1 FROM player_games WHERE user_id = NEW.user_id AND game_id = NEW.game_id AND verified
+  ) THEN
+    PERFORM add_verified_delta(NEW.game_id, 1, NEW.score);
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION apply_player_verification() RETURNS trigger AS $$
+DECLARE
+  was BOOLEAN := TG_OP <> 'INSERT' AND OLD.verified;
+  now_verified BOOLEAN := TG_OP <> 'DELETE' AND NEW.verified;
+  uid UUID;
+  gid UUID;
+  s   NUMERIC;
+BEGIN
+  IF was = now_verified THEN
+    RETURN NULL;
+  END IF;
+  IF TG_OP = 'DELETE' THEN
+    uid := OLD.user_id; gid := OLD.game_id;
+  ELSE
+    uid := NEW.user_id; gid := NEW.game_id;
+  END IF;
+
+  SELECT score INTO s FROM ratings WHERE user_id = uid AND game_id = gid;
+  IF FOUND THEN
+    IF now_verified THEN
+      PERFORM add_verified_delta(gid, 1, s);
+    ELSE
+      PERFORM add_verified_delta(gid, -1, -s);
+    END IF;
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER ratings_apply_verified
+  AFTER INSERT OR UPDATE OF score OR DELETE ON ratings
+  FOR EACH ROW EXECUTE FUNCTION apply_verified_rating();
+
+CREATE TRIGGER player_games_apply_verification
+  AFTER INSERT OR UPDATE OR DELETE ON player_games
+  FOR EACH ROW EXECUTE FUNCTION apply_player_verification();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS player_games_apply_verification ON player_games;
+DROP TRIGGER IF EXISTS ratings_apply_verified ON ratings;
+DROP FUNCTION IF EXISTS apply_player_verification();
+DROP FUNCTION IF EXISTS apply_verified_rating();
+DROP FUNCTION IF EXISTS add_verified_delta(UUID, BIGINT, NUMERIC);
+ALTER TABLE game_freezes
+  DROP COLUMN IF EXISTS verified_count,
+  DROP COLUMN IF EXISTS verified_average;
+DROP INDEX IF EXISTS game_ratings_verified_rank_idx;
+ALTER TABLE game_ratings
+  DROP COLUMN IF EXISTS verified_average,
+  DROP COLUMN IF EXISTS verified_sum,
+  DROP COLUMN IF EXISTS verified_count;
+DROP TABLE IF EXISTS player_games;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- Проекция метаданных каталога из топика каталога: только то, по чему фильтруются топы.
+-- genre и tags хранятся в нижнем регистре. Удалённая из каталога игра остаётся строкой
+-- с deleted, чтобы запоздавшее обновление её не воскресило.
+CREATE TABLE IF NOT EXISTS game_catalog (
+  game_id      UUID        PRIMARY KEY,
+  genre        TEXT,
+  tags         TEXT[]      NOT NULL DEFAULT '{}',
+  release_year INT,
+  publisher    TEXT,
+  deleted      BOOLEAN     NOT NULL DEFAULT false,
+  -- время изменения в каталоге: более старые события строку не меняют
+  updated_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS game_catalog_genre_year_idx
+  ON game_catalog (genre, release_year) WHERE NOT deleted;
+CREATE INDEX IF NOT EXISTS game_catalog_year_idx
+  ON game_catalog (release_year) WHERE NOT deleted;
+CREATE INDEX IF NOT EXISTS game_catalog_publisher_idx
+  ON game_catalog (lower(publisher)) WHERE NOT deleted;
+CREATE INDEX IF NOT EXISTS game_catalog_tags_idx
+  ON game_catalog USING GIN (tags) WHERE NOT deleted;
+
+-- Топ (в том числе отфильтрованный) идёт по этому индексу и проверяет фильтр по
+-- game_catalog для каждой игры, пока не наберёт страницу (см. GetTopGamesRepo).
+CREATE INDEX IF NOT EXISTS game_ratings_rank_idx
+  ON game_ratings (average_rating DESC NULLS LAST, game_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS game_ratings_rank_idx;
+DROP TABLE IF EXISTS game_catalog;
@@ -0,0 +1,141 @@
+-- +goose Up
+ALTER TABLE game_catalog ADD COLUMN IF NOT EXISTS developer TEXT;
+
+CREATE INDEX IF NOT EXISTS game_catalog_developer_idx
+  ON game_catalog (lower(developer)) WHERE NOT deleted;
+
+-- Агрегат студии (разработчика или издателя) по оценённым играм каталога: среднее
+-- взвешено числом оценок, т.е. это среднее по всем оценкам всех игр студии.
+-- Студии различаются без учёта регистра (studio_key), name - написание из каталога.
+CREATE TABLE IF NOT EXISTS studio_ratings (
+  role           TEXT          NOT NULL CHECK (role IN ('developer', 'publisher')),
+  studio_key     TEXT          NOT NULL,
+  name           TEXT          NOT NULL,
+  games_count    INT           NOT NULL DEFAULT 0,
+  ratings_count  BIGINT        NOT NULL DEFAULT 0,
+  ratings_sum    NUMERIC(18,2) NOT NULL DEFAULT 0,
+  average_rating NUMERIC(4,2),
+  PRIMARY KEY (role, studio_key)
+);
+
+CREATE INDEX IF NOT EXISTS studio_ratings_rank_idx
+  ON studio_ratings (role, average_rating DESC NULLS LAST, ratings_count DESC);
+
+-- Агрегаты студий ведут триггеры: на game_ratings (изменились оценки игры) и на
+-- game_catalog (у игры сменились студии, она удалена или появилась в каталоге).
+-- Игра без оценок студии не учитывается; студия без оценённых игр удаляется.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION add_studio_delta(r TEXT, studio TEXT, dgames INT, dcount BIGINT, dsum NUMERIC)
+RETURNS void AS $$
+BEGIN
+  studio := btrim(studio);
+  IF studio IS NULL OR studio = '' OR (dgames = 0 AND dcount = 0 AND dsum = 0) THEN
+    RETURN;
+  END IF;
+
+  INSERT INTO studio_ratings AS s (role, studio_key, name, games_count, ratings_count, ratings_sum, average_rating)
+  VALUES (r, lower(studio), studio, dgames, dcount, dsum, ROUND(dsum / NULLIF(dcount, 0), 2))
+  ON CONFLICT (role, studio_key) DO UPDATE
+    SET name           = EXCLUDED.name,
+        games_count    = s.games_count + EXCLUDED.games_count,
+        ratings_count  = s.ratings_count + EXCLUDED.ratings_count,
+        ratings_sum    = s.ratings_sum + EXCLUDED.ratings_sum,
+        average_rating = ROUND((s.ratings_sum + EXCLUDED.ratings_sum)
+                               / NULLIF(s.ratings_count + EXCLUDED.ratings_count, 0), 2);
+
+  DELETE FROM studio_ratings WHERE role = r AND studio_key = lower(studio) AND games_count <= 0;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION apply_studio_rating() RETURNS trigger AS $$
+DECLARE
+  gid  UUID;
+  ocnt BIGINT  := 0;
+  osum NUMERIC := 0;
+  ncnt BIGINT  := 0;
+  nsum NUMERIC := 0;
+  dgames INT;
+  dev  TEXT;
+  pub  TEXT;
+BEGIN
+  IF TG_OP <> 'INSERT' THEN
+    gid := OLD.game_id; ocnt := OLD.ratings_count; osum := OLD.ratings_sum;
+  END IF;
+  IF TG_OP <> 'DELETE' THEN
+    gid := NEW.game_id; ncnt := NEW.ratings_count; nsum := NEW.ratings_sum;
+  END IF;
+  IF ocnt = ncnt AND osum = nsum THEN
+    RETURN NULL;
+  END IF;
+
+  SELECT developer, publisher INTO dev, pub FROM game_catalog WHERE game_id = gid AND NOT deleted;
+  IF NOT FOUND THEN
+    RETURN NULL;
+  END IF;
+
+  dgames := (ncnt > 0)::int - (ocnt > 0)::int;
+  PERFORM add_studio_delta('developer', dev, dgames, ncnt - ocnt, nsum - osum);
+  PERFORM add_studio_delta('publisher', pub, dgames, ncnt - ocnt, nsum - osum);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION apply_catalog_studios() RETURNS trigger AS $$
+DECLARE
+  cnt BIGINT;
+  s   NUMERIC;
+BEGIN
+  IF TG_OP = 'UPDATE'
+     AND OLD.deleted = NEW.deleted
+     AND OLD.developer IS NOT DISTINCT FROM NEW.developer
+     AND OLD.publisher IS NOT DISTINCT FROM NEW.publisher THEN
+    RETURN NULL;
+  END IF;
+
+  SELECT ratings_count, ratings_sum INTO cnt, s
+  FROM game_ratings
+  WHERE game_id = CASE WHEN TG_OP = 'DELETE' THEN OLD.game_id ELSE NEW.game_id END;
+  IF NOT FOUND OR cnt = 0 THEN
+    RETURN NULL;
+  END IF;
+
+  IF TG_OP <> 'INSERT' AND NOT OLD.deleted THEN
+    PERFORM add_studio_delta('developer', OLD.developer, -1, -cnt, -s);
+    PERFORM add_studio_delta('publisher', OLD.publisher, -1, -cnt, -s);
+  END IF;
+  IF TG_OP <> 'DELETE' AND NOT NEW.deleted THEN
+    PERFORM add_studio_delta('developer', NEW.developer, 1, cnt, s);
+    PERFORM add_studio_delta('publisher', NEW.publisher, 1, cnt, s);
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER game_ratings_apply_studio
+  AFTER INSERT OR UPDATE OF ratings_count, ratings_sum OR DELETE ON game_ratings
+  FOR EACH ROW EXECUTE FUNCTION apply_studio_rating();
+
+CREATE TRIGGER game_catalog_apply_studios
+  AFTER INSERT OR UPDATE OR DELETE ON game_catalog
+  FOR EACH ROW EXECUTE FUNCTION apply_catalog_studios();
+
+-- разработчиков в проекции ещё нет, издатели - из уже загруженного каталога
+INSERT INTO studio_ratings (role, studio_key, name, games_count, ratings_count, ratings_sum, average_rating)
+SELECT 'publisher', lower(btrim(c.publisher)), MAX(btrim(c.publisher)), COUNT(*),
+       SUM(g.ratings_count), SUM(g.ratings_sum), ROUND(SUM(g.ratings_sum)::numeric / SUM(g.ratings_count), 2)
+FROM game_catalog c
+JOIN game_ratings g USING (game_id)
+WHERE NOT c.deleted AND btrim(c.publisher) <> '' AND g.ratings_count > 0
+GROUP BY lower(btrim(c.publisher))
+ON CONFLICT DO NOTHING;
+
+-- +goose Down
+DROP TRIGGER IF EXISTS game_catalog_apply_studios ON game_catalog;
+DROP TRIGGER IF EXISTS game_ratings_apply_studio ON game_ratings;
+DROP FUNCTION IF EXISTS apply_catalog_studios();
+DROP FUNCTION IF EXISTS apply_studio_rating();
+DROP FUNCTION IF EXISTS add_studio_delta(TEXT, TEXT, INT, BIGINT, NUMERIC);
+DROP TABLE IF EXISTS studio_ratings;
+DROP INDEX IF EXISTS game_catalog_developer_idx;
+ALTER TABLE game_catalog DROP COLUMN IF EXISTS developer;
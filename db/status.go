@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pressly/goose/v3"
+)
+
+// LatestVersion - номер последней миграции, вшитой в бинарник.
+func LatestVersion() (int64, error) {
+	entries, err := fs.ReadDir(embedMigrations, "migrations")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest int64
+	for _, e := range entries {
+		v, err := goose.NumericComponent(e.Name())
+		if err != nil {
+			continue
+		}
+		latest = max(latest, v)
+	}
+	return latest, nil
+}
+
+// CheckVersion проверяет, что в базе применены все вшитые миграции.
+func CheckVersion(ctx context.Context, pool *pgxpool.Pool) error {
+	want, err := LatestVersion()
+	if err != nil {
+		return fmt.Errorf("read embedded migrations: %w", err)
+	}
+
+	var got int64
+	err = pool.QueryRow(ctx, `
+      SELECT COALESCE(MAX(version_id), 0)
+      FROM goose_db_version
+      WHERE is_applied
+    `).Scan(&got)
+	if err != nil {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+
+	if got < want {
+		return fmt.Errorf("schema version %d is behind %d", got, want)
+	}
+	return nil
+}
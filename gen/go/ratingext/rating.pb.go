@@ -0,0 +1,605 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ratingext/rating.proto
+
+package ratingextv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubmitRatingRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// значение в шкале scale
+	Rating int32 `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	// пусто - validation.default_scale из конфига
+	Scale         string `protobuf:"bytes,4,opt,name=scale,proto3" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRatingRequest) Reset() {
+	*x = SubmitRatingRequest{}
+	mi := &file_ratingext_rating_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRatingRequest) ProtoMessage() {}
+
+func (x *SubmitRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRatingRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRatingRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubmitRatingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitRatingRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SubmitRatingRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SubmitRatingRequest) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+type SubmitRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRatingResponse) Reset() {
+	*x = SubmitRatingResponse{}
+	mi := &file_ratingext_rating_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRatingResponse) ProtoMessage() {}
+
+func (x *SubmitRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRatingResponse.ProtoReflect.Descriptor instead.
+func (*SubmitRatingResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_proto_rawDescGZIP(), []int{1}
+}
+
+type GetGameRatingRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// шкала, на которую проецируются средние; пусто - каноническая 1-10
+	Scale         string `protobuf:"bytes,2,opt,name=scale,proto3" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameRatingRequest) Reset() {
+	*x = GetGameRatingRequest{}
+	mi := &file_ratingext_rating_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRatingRequest) ProtoMessage() {}
+
+func (x *GetGameRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRatingRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRatingRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetGameRatingRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetGameRatingRequest) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+type GameRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,2,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	RatingsCount  int64                  `protobuf:"varint,3,opt,name=ratings_count,json=ratingsCount,proto3" json:"ratings_count,omitempty"`
+	// шкала, на которую спроецированы средние
+	Scale string `protobuf:"bytes,4,opt,name=scale,proto3" json:"scale,omitempty"`
+	// среднее с поправкой на манеру оценивания; нет - игру ещё не пересчитывали
+	NormalizedRating *float64 `protobuf:"fixed64,5,opt,name=normalized_rating,json=normalizedRating,proto3,oneof" json:"normalized_rating,omitempty"`
+	// среднее с весами оценщиков; нет - весов ещё нет
+	WeightedRating *float64 `protobuf:"fixed64,6,opt,name=weighted_rating,json=weightedRating,proto3,oneof" json:"weighted_rating,omitempty"`
+	// игра заморожена: значения - снимок на момент заморозки
+	Frozen bool `protobuf:"varint,7,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// среднее и число оценок игроков, которые владеют игрой и наиграли порог
+	VerifiedRating *float64 `protobuf:"fixed64,8,opt,name=verified_rating,json=verifiedRating,proto3,oneof" json:"verified_rating,omitempty"`
+	VerifiedCount  int64    `protobuf:"varint,9,opt,name=verified_count,json=verifiedCount,proto3" json:"verified_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GameRating) Reset() {
+	*x = GameRating{}
+	mi := &file_ratingext_rating_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRating) ProtoMessage() {}
+
+func (x *GameRating) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRating.ProtoReflect.Descriptor instead.
+func (*GameRating) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GameRating) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameRating) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *GameRating) GetRatingsCount() int64 {
+	if x != nil {
+		return x.RatingsCount
+	}
+	return 0
+}
+
+func (x *GameRating) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+func (x *GameRating) GetNormalizedRating() float64 {
+	if x != nil && x.NormalizedRating != nil {
+		return *x.NormalizedRating
+	}
+	return 0
+}
+
+func (x *GameRating) GetWeightedRating() float64 {
+	if x != nil && x.WeightedRating != nil {
+		return *x.WeightedRating
+	}
+	return 0
+}
+
+func (x *GameRating) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *GameRating) GetVerifiedRating() float64 {
+	if x != nil && x.VerifiedRating != nil {
+		return *x.VerifiedRating
+	}
+	return 0
+}
+
+func (x *GameRating) GetVerifiedCount() int64 {
+	if x != nil {
+		return x.VerifiedCount
+	}
+	return 0
+}
+
+type TopFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// без учёта регистра
+	Genre string `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
+	// игра должна иметь все теги
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 0 - любой год
+	ReleaseYear   int32  `protobuf:"varint,3,opt,name=release_year,json=releaseYear,proto3" json:"release_year,omitempty"`
+	Publisher     string `protobuf:"bytes,4,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopFilter) Reset() {
+	*x = TopFilter{}
+	mi := &file_ratingext_rating_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopFilter) ProtoMessage() {}
+
+func (x *TopFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopFilter.ProtoReflect.Descriptor instead.
+func (*TopFilter) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TopFilter) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *TopFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TopFilter) GetReleaseYear() int32 {
+	if x != nil {
+		return x.ReleaseYear
+	}
+	return 0
+}
+
+func (x *TopFilter) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
+	}
+	return ""
+}
+
+type GetTopGamesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// как в gamehub.RatingService - 10
+	Limit  int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Scale  string `protobuf:"bytes,3,opt,name=scale,proto3" json:"scale,omitempty"`
+	// "average" (пусто), "normalized", "weighted" или "verified"
+	Order         string     `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	Filter        *TopFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopGamesRequest) Reset() {
+	*x = GetTopGamesRequest{}
+	mi := &file_ratingext_rating_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopGamesRequest) ProtoMessage() {}
+
+func (x *GetTopGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopGamesRequest.ProtoReflect.Descriptor instead.
+func (*GetTopGamesRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTopGamesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTopGamesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetTopGamesRequest) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+func (x *GetTopGamesRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *GetTopGamesRequest) GetFilter() *TopFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetTopGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scale         string                 `protobuf:"bytes,1,opt,name=scale,proto3" json:"scale,omitempty"`
+	Games         []*GameRating          `protobuf:"bytes,2,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopGamesResponse) Reset() {
+	*x = GetTopGamesResponse{}
+	mi := &file_ratingext_rating_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopGamesResponse) ProtoMessage() {}
+
+func (x *GetTopGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopGamesResponse.ProtoReflect.Descriptor instead.
+func (*GetTopGamesResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTopGamesResponse) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+func (x *GetTopGamesResponse) GetGames() []*GameRating {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+var File_ratingext_rating_proto protoreflect.FileDescriptor
+
+const file_ratingext_rating_proto_rawDesc = "" +
+	"\n" +
+	"\x16ratingext/rating.proto\x12\x11gamehub.ratingext\"u\n" +
+	"\x13SubmitRatingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05scale\x18\x04 \x01(\tR\x05scale\"\x16\n" +
+	"\x14SubmitRatingResponse\"E\n" +
+	"\x14GetGameRatingRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x14\n" +
+	"\x05scale\x18\x02 \x01(\tR\x05scale\"\x92\x03\n" +
+	"\n" +
+	"GameRating\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12%\n" +
+	"\x0eaverage_rating\x18\x02 \x01(\x01R\raverageRating\x12#\n" +
+	"\rratings_count\x18\x03 \x01(\x03R\fratingsCount\x12\x14\n" +
+	"\x05scale\x18\x04 \x01(\tR\x05scale\x120\n" +
+	"\x11normalized_rating\x18\x05 \x01(\x01H\x00R\x10normalizedRating\x88\x01\x01\x12,\n" +
+	"\x0fweighted_rating\x18\x06 \x01(\x01H\x01R\x0eweightedRating\x88\x01\x01\x12\x16\n" +
+	"\x06frozen\x18\a \x01(\bR\x06frozen\x12,\n" +
+	"\x0fverified_rating\x18\b \x01(\x01H\x02R\x0everifiedRating\x88\x01\x01\x12%\n" +
+	"\x0everified_count\x18\t \x01(\x03R\rverifiedCountB\x14\n" +
+	"\x12_normalized_ratingB\x12\n" +
+	"\x10_weighted_ratingB\x12\n" +
+	"\x10_verified_rating\"v\n" +
+	"\tTopFilter\x12\x14\n" +
+	"\x05genre\x18\x01 \x01(\tR\x05genre\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12!\n" +
+	"\frelease_year\x18\x03 \x01(\x05R\vreleaseYear\x12\x1c\n" +
+	"\tpublisher\x18\x04 \x01(\tR\tpublisher\"\xa4\x01\n" +
+	"\x12GetTopGamesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05scale\x18\x03 \x01(\tR\x05scale\x12\x14\n" +
+	"\x05order\x18\x04 \x01(\tR\x05order\x124\n" +
+	"\x06filter\x18\x05 \x01(\v2\x1c.gamehub.ratingext.TopFilterR\x06filter\"`\n" +
+	"\x13GetTopGamesResponse\x12\x14\n" +
+	"\x05scale\x18\x01 \x01(\tR\x05scale\x123\n" +
+	"\x05games\x18\x02 \x03(\v2\x1d.gamehub.ratingext.GameRatingR\x05games2\xa7\x02\n" +
+	"\rRatingService\x12_\n" +
+	"\fSubmitRating\x12&.gamehub.ratingext.SubmitRatingRequest\x1a'.gamehub.ratingext.SubmitRatingResponse\x12W\n" +
+	"\rGetGameRating\x12'.gamehub.ratingext.GetGameRatingRequest\x1a\x1d.gamehub.ratingext.GameRating\x12\\\n" +
+	"\vGetTopGames\x12%.gamehub.ratingext.GetTopGamesRequest\x1a&.gamehub.ratingext.GetTopGamesResponseBFZDgithub.com/RozmiDan/gameReviewHubRating/gen/go/ratingext;ratingextv1b\x06proto3"
+
+var (
+	file_ratingext_rating_proto_rawDescOnce sync.Once
+	file_ratingext_rating_proto_rawDescData []byte
+)
+
+func file_ratingext_rating_proto_rawDescGZIP() []byte {
+	file_ratingext_rating_proto_rawDescOnce.Do(func() {
+		file_ratingext_rating_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ratingext_rating_proto_rawDesc), len(file_ratingext_rating_proto_rawDesc)))
+	})
+	return file_ratingext_rating_proto_rawDescData
+}
+
+var file_ratingext_rating_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_ratingext_rating_proto_goTypes = []any{
+	(*SubmitRatingRequest)(nil),  // 0: gamehub.ratingext.SubmitRatingRequest
+	(*SubmitRatingResponse)(nil), // 1: gamehub.ratingext.SubmitRatingResponse
+	(*GetGameRatingRequest)(nil), // 2: gamehub.ratingext.GetGameRatingRequest
+	(*GameRating)(nil),           // 3: gamehub.ratingext.GameRating
+	(*TopFilter)(nil),            // 4: gamehub.ratingext.TopFilter
+	(*GetTopGamesRequest)(nil),   // 5: gamehub.ratingext.GetTopGamesRequest
+	(*GetTopGamesResponse)(nil),  // 6: gamehub.ratingext.GetTopGamesResponse
+}
+var file_ratingext_rating_proto_depIdxs = []int32{
+	4, // 0: gamehub.ratingext.GetTopGamesRequest.filter:type_name -> gamehub.ratingext.TopFilter
+	3, // 1: gamehub.ratingext.GetTopGamesResponse.games:type_name -> gamehub.ratingext.GameRating
+	0, // 2: gamehub.ratingext.RatingService.SubmitRating:input_type -> gamehub.ratingext.SubmitRatingRequest
+	2, // 3: gamehub.ratingext.RatingService.GetGameRating:input_type -> gamehub.ratingext.GetGameRatingRequest
+	5, // 4: gamehub.ratingext.RatingService.GetTopGames:input_type -> gamehub.ratingext.GetTopGamesRequest
+	1, // 5: gamehub.ratingext.RatingService.SubmitRating:output_type -> gamehub.ratingext.SubmitRatingResponse
+	3, // 6: gamehub.ratingext.RatingService.GetGameRating:output_type -> gamehub.ratingext.GameRating
+	6, // 7: gamehub.ratingext.RatingService.GetTopGames:output_type -> gamehub.ratingext.GetTopGamesResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ratingext_rating_proto_init() }
+func file_ratingext_rating_proto_init() {
+	if File_ratingext_rating_proto != nil {
+		return
+	}
+	file_ratingext_rating_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ratingext_rating_proto_rawDesc), len(file_ratingext_rating_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ratingext_rating_proto_goTypes,
+		DependencyIndexes: file_ratingext_rating_proto_depIdxs,
+		MessageInfos:      file_ratingext_rating_proto_msgTypes,
+	}.Build()
+	File_ratingext_rating_proto = out.File
+	file_ratingext_rating_proto_goTypes = nil
+	file_ratingext_rating_proto_depIdxs = nil
+}
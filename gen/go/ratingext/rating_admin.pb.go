@@ -0,0 +1,1493 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ratingext/rating_admin.proto
+
+package ratingextv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Оценки пользователя user_id: только игры game_id, если задана, поставленные
+// в [from, to), если заданы. Одна оценка - user_id и game_id без окна.
+type ModerationTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationTarget) Reset() {
+	*x = ModerationTarget{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationTarget) ProtoMessage() {}
+
+func (x *ModerationTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationTarget.ProtoReflect.Descriptor instead.
+func (*ModerationTarget) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ModerationTarget) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ModerationTarget) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ModerationTarget) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ModerationTarget) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ModerateRatingsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Target *ModerationTarget      `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// кто выполняет действие; обязательно
+	Moderator string `protobuf:"bytes,2,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	// причина; обязательна
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateRatingsRequest) Reset() {
+	*x = ModerateRatingsRequest{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateRatingsRequest) ProtoMessage() {}
+
+func (x *ModerateRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateRatingsRequest.ProtoReflect.Descriptor instead.
+func (*ModerateRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ModerateRatingsRequest) GetTarget() *ModerationTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ModerateRatingsRequest) GetModerator() string {
+	if x != nil {
+		return x.Moderator
+	}
+	return ""
+}
+
+func (x *ModerateRatingsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ModerationAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "hide" или "unhide"
+	Action    string            `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Moderator string            `protobuf:"bytes,3,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	Reason    string            `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Target    *ModerationTarget `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	// сколько оценок скрыто или возвращено
+	Affected  int64                  `protobuf:"varint,6,opt,name=affected,proto3" json:"affected,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// игры, чьи агрегаты пересчитаны; только в ответе HideRatings/UnhideRatings
+	Games         []string `protobuf:"bytes,8,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationAction) Reset() {
+	*x = ModerationAction{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationAction) ProtoMessage() {}
+
+func (x *ModerationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationAction.ProtoReflect.Descriptor instead.
+func (*ModerationAction) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ModerationAction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerationAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetModerator() string {
+	if x != nil {
+		return x.Moderator
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetTarget() *ModerationTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ModerationAction) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *ModerationAction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ModerationAction) GetGames() []string {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type ListModerationActionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// фильтры; пусто - без фильтра
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId    string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Moderator string `protobuf:"bytes,3,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	// 0 - 50; не больше moderation.max_page_size из конфига
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token предыдущего ответа
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationActionsRequest) Reset() {
+	*x = ListModerationActionsRequest{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationActionsRequest) ProtoMessage() {}
+
+func (x *ListModerationActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationActionsRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationActionsRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListModerationActionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListModerationActionsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ListModerationActionsRequest) GetModerator() string {
+	if x != nil {
+		return x.Moderator
+	}
+	return ""
+}
+
+func (x *ListModerationActionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListModerationActionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListModerationActionsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Actions []*ModerationAction    `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	// пусто - больше записей нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationActionsResponse) Reset() {
+	*x = ListModerationActionsResponse{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationActionsResponse) ProtoMessage() {}
+
+func (x *ListModerationActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationActionsResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationActionsResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListModerationActionsResponse) GetActions() []*ModerationAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *ListModerationActionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type FreezeGameRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	GameId    string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Moderator string                 `protobuf:"bytes,2,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	Reason    string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// не задано или 0 - до ручного UnfreezeGame
+	Ttl           *durationpb.Duration `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeGameRequest) Reset() {
+	*x = FreezeGameRequest{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeGameRequest) ProtoMessage() {}
+
+func (x *FreezeGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeGameRequest.ProtoReflect.Descriptor instead.
+func (*FreezeGameRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FreezeGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *FreezeGameRequest) GetModerator() string {
+	if x != nil {
+		return x.Moderator
+	}
+	return ""
+}
+
+func (x *FreezeGameRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FreezeGameRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type UnfreezeGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Moderator     string                 `protobuf:"bytes,2,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfreezeGameRequest) Reset() {
+	*x = UnfreezeGameRequest{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfreezeGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeGameRequest) ProtoMessage() {}
+
+func (x *UnfreezeGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeGameRequest.ProtoReflect.Descriptor instead.
+func (*UnfreezeGameRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnfreezeGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *UnfreezeGameRequest) GetModerator() string {
+	if x != nil {
+		return x.Moderator
+	}
+	return ""
+}
+
+func (x *UnfreezeGameRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GameFreeze struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GameId   string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	FrozenAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+	// не задано - до ручного снятия
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// кто заморозил и почему
+	Moderator string `protobuf:"bytes,4,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	Reason    string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// снимок рейтинга на шкале 1-10, который видят клиенты
+	AverageRating float64 `protobuf:"fixed64,6,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	RatingsCount  int64   `protobuf:"varint,7,opt,name=ratings_count,json=ratingsCount,proto3" json:"ratings_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameFreeze) Reset() {
+	*x = GameFreeze{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameFreeze) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameFreeze) ProtoMessage() {}
+
+func (x *GameFreeze) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameFreeze.ProtoReflect.Descriptor instead.
+func (*GameFreeze) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GameFreeze) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameFreeze) GetFrozenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FrozenAt
+	}
+	return nil
+}
+
+func (x *GameFreeze) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GameFreeze) GetModerator() string {
+	if x != nil {
+		return x.Moderator
+	}
+	return ""
+}
+
+func (x *GameFreeze) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GameFreeze) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *GameFreeze) GetRatingsCount() int64 {
+	if x != nil {
+		return x.RatingsCount
+	}
+	return 0
+}
+
+type ListFrozenGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFrozenGamesRequest) Reset() {
+	*x = ListFrozenGamesRequest{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFrozenGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFrozenGamesRequest) ProtoMessage() {}
+
+func (x *ListFrozenGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFrozenGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListFrozenGamesRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{8}
+}
+
+type ListFrozenGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*GameFreeze          `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFrozenGamesResponse) Reset() {
+	*x = ListFrozenGamesResponse{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFrozenGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFrozenGamesResponse) ProtoMessage() {}
+
+func (x *ListFrozenGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFrozenGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListFrozenGamesResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListFrozenGamesResponse) GetGames() []*GameFreeze {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type SetGameReleaseRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	GameId    string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	ReleaseAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+	// "reject" или "park"; пусто - embargo.default_policy из конфига
+	Policy        string `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGameReleaseRequest) Reset() {
+	*x = SetGameReleaseRequest{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGameReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGameReleaseRequest) ProtoMessage() {}
+
+func (x *SetGameReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGameReleaseRequest.ProtoReflect.Descriptor instead.
+func (*SetGameReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetGameReleaseRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SetGameReleaseRequest) GetReleaseAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return nil
+}
+
+func (x *SetGameReleaseRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+type GameRelease struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	GameId    string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	ReleaseAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+	// пусто - политика из конфига
+	Policy        string                 `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameRelease) Reset() {
+	*x = GameRelease{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRelease) ProtoMessage() {}
+
+func (x *GameRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRelease.ProtoReflect.Descriptor instead.
+func (*GameRelease) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GameRelease) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameRelease) GetReleaseAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return nil
+}
+
+func (x *GameRelease) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *GameRelease) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type DeleteGameReleaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameReleaseRequest) Reset() {
+	*x = DeleteGameReleaseRequest{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameReleaseRequest) ProtoMessage() {}
+
+func (x *DeleteGameReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameReleaseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteGameReleaseRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type DeleteGameReleaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameReleaseResponse) Reset() {
+	*x = DeleteGameReleaseResponse{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameReleaseResponse) ProtoMessage() {}
+
+func (x *DeleteGameReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameReleaseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGameReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{13}
+}
+
+type ListUpcomingReleasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingReleasesRequest) Reset() {
+	*x = ListUpcomingReleasesRequest{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingReleasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingReleasesRequest) ProtoMessage() {}
+
+func (x *ListUpcomingReleasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingReleasesRequest.ProtoReflect.Descriptor instead.
+func (*ListUpcomingReleasesRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{14}
+}
+
+type ListUpcomingReleasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*GameRelease         `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingReleasesResponse) Reset() {
+	*x = ListUpcomingReleasesResponse{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingReleasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingReleasesResponse) ProtoMessage() {}
+
+func (x *ListUpcomingReleasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingReleasesResponse.ProtoReflect.Descriptor instead.
+func (*ListUpcomingReleasesResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListUpcomingReleasesResponse) GetGames() []*GameRelease {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type GrantEmbargoExemptionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// кто выдаёт исключение и почему; обязательны
+	GrantedBy     string `protobuf:"bytes,2,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantEmbargoExemptionRequest) Reset() {
+	*x = GrantEmbargoExemptionRequest{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantEmbargoExemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantEmbargoExemptionRequest) ProtoMessage() {}
+
+func (x *GrantEmbargoExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantEmbargoExemptionRequest.ProtoReflect.Descriptor instead.
+func (*GrantEmbargoExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GrantEmbargoExemptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GrantEmbargoExemptionRequest) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *GrantEmbargoExemptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EmbargoExemption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GrantedBy     string                 `protobuf:"bytes,2,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbargoExemption) Reset() {
+	*x = EmbargoExemption{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbargoExemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbargoExemption) ProtoMessage() {}
+
+func (x *EmbargoExemption) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbargoExemption.ProtoReflect.Descriptor instead.
+func (*EmbargoExemption) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EmbargoExemption) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EmbargoExemption) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *EmbargoExemption) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EmbargoExemption) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RevokeEmbargoExemptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeEmbargoExemptionRequest) Reset() {
+	*x = RevokeEmbargoExemptionRequest{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeEmbargoExemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeEmbargoExemptionRequest) ProtoMessage() {}
+
+func (x *RevokeEmbargoExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeEmbargoExemptionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeEmbargoExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevokeEmbargoExemptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevokeEmbargoExemptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeEmbargoExemptionResponse) Reset() {
+	*x = RevokeEmbargoExemptionResponse{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeEmbargoExemptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeEmbargoExemptionResponse) ProtoMessage() {}
+
+func (x *RevokeEmbargoExemptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeEmbargoExemptionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeEmbargoExemptionResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{19}
+}
+
+type ListEmbargoExemptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmbargoExemptionsRequest) Reset() {
+	*x = ListEmbargoExemptionsRequest{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmbargoExemptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmbargoExemptionsRequest) ProtoMessage() {}
+
+func (x *ListEmbargoExemptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmbargoExemptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEmbargoExemptionsRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{20}
+}
+
+type ListEmbargoExemptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*EmbargoExemption    `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmbargoExemptionsResponse) Reset() {
+	*x = ListEmbargoExemptionsResponse{}
+	mi := &file_ratingext_rating_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmbargoExemptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmbargoExemptionsResponse) ProtoMessage() {}
+
+func (x *ListEmbargoExemptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmbargoExemptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListEmbargoExemptionsResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListEmbargoExemptionsResponse) GetUsers() []*EmbargoExemption {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+var File_ratingext_rating_admin_proto protoreflect.FileDescriptor
+
+const file_ratingext_rating_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x1cratingext/rating_admin.proto\x12\x11gamehub.ratingext\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa0\x01\n" +
+	"\x10ModerationTarget\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\x8b\x01\n" +
+	"\x16ModerateRatingsRequest\x12;\n" +
+	"\x06target\x18\x01 \x01(\v2#.gamehub.ratingext.ModerationTargetR\x06target\x12\x1c\n" +
+	"\tmoderator\x18\x02 \x01(\tR\tmoderator\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9a\x02\n" +
+	"\x10ModerationAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1c\n" +
+	"\tmoderator\x18\x03 \x01(\tR\tmoderator\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12;\n" +
+	"\x06target\x18\x05 \x01(\v2#.gamehub.ratingext.ModerationTargetR\x06target\x12\x1a\n" +
+	"\baffected\x18\x06 \x01(\x03R\baffected\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05games\x18\b \x03(\tR\x05games\"\xaa\x01\n" +
+	"\x1cListModerationActionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1c\n" +
+	"\tmoderator\x18\x03 \x01(\tR\tmoderator\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\x86\x01\n" +
+	"\x1dListModerationActionsResponse\x12=\n" +
+	"\aactions\x18\x01 \x03(\v2#.gamehub.ratingext.ModerationActionR\aactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8f\x01\n" +
+	"\x11FreezeGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1c\n" +
+	"\tmoderator\x18\x02 \x01(\tR\tmoderator\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12+\n" +
+	"\x03ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"d\n" +
+	"\x13UnfreezeGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1c\n" +
+	"\tmoderator\x18\x02 \x01(\tR\tmoderator\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9b\x02\n" +
+	"\n" +
+	"GameFreeze\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x127\n" +
+	"\tfrozen_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfrozenAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1c\n" +
+	"\tmoderator\x18\x04 \x01(\tR\tmoderator\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12%\n" +
+	"\x0eaverage_rating\x18\x06 \x01(\x01R\raverageRating\x12#\n" +
+	"\rratings_count\x18\a \x01(\x03R\fratingsCount\"\x18\n" +
+	"\x16ListFrozenGamesRequest\"N\n" +
+	"\x17ListFrozenGamesResponse\x123\n" +
+	"\x05games\x18\x01 \x03(\v2\x1d.gamehub.ratingext.GameFreezeR\x05games\"\x83\x01\n" +
+	"\x15SetGameReleaseRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x129\n" +
+	"\n" +
+	"release_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\treleaseAt\x12\x16\n" +
+	"\x06policy\x18\x03 \x01(\tR\x06policy\"\xb4\x01\n" +
+	"\vGameRelease\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x129\n" +
+	"\n" +
+	"release_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\treleaseAt\x12\x16\n" +
+	"\x06policy\x18\x03 \x01(\tR\x06policy\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"3\n" +
+	"\x18DeleteGameReleaseRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"\x1b\n" +
+	"\x19DeleteGameReleaseResponse\"\x1d\n" +
+	"\x1bListUpcomingReleasesRequest\"T\n" +
+	"\x1cListUpcomingReleasesResponse\x124\n" +
+	"\x05games\x18\x01 \x03(\v2\x1e.gamehub.ratingext.GameReleaseR\x05games\"n\n" +
+	"\x1cGrantEmbargoExemptionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x02 \x01(\tR\tgrantedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9d\x01\n" +
+	"\x10EmbargoExemption\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x02 \x01(\tR\tgrantedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"8\n" +
+	"\x1dRevokeEmbargoExemptionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\" \n" +
+	"\x1eRevokeEmbargoExemptionResponse\"\x1e\n" +
+	"\x1cListEmbargoExemptionsRequest\"Z\n" +
+	"\x1dListEmbargoExemptionsResponse\x129\n" +
+	"\x05users\x18\x01 \x03(\v2#.gamehub.ratingext.EmbargoExemptionR\x05users2\x93\n" +
+	"\n" +
+	"\x12RatingAdminService\x12]\n" +
+	"\vHideRatings\x12).gamehub.ratingext.ModerateRatingsRequest\x1a#.gamehub.ratingext.ModerationAction\x12_\n" +
+	"\rUnhideRatings\x12).gamehub.ratingext.ModerateRatingsRequest\x1a#.gamehub.ratingext.ModerationAction\x12z\n" +
+	"\x15ListModerationActions\x12/.gamehub.ratingext.ListModerationActionsRequest\x1a0.gamehub.ratingext.ListModerationActionsResponse\x12Q\n" +
+	"\n" +
+	"FreezeGame\x12$.gamehub.ratingext.FreezeGameRequest\x1a\x1d.gamehub.ratingext.GameFreeze\x12U\n" +
+	"\fUnfreezeGame\x12&.gamehub.ratingext.UnfreezeGameRequest\x1a\x1d.gamehub.ratingext.GameFreeze\x12h\n" +
+	"\x0fListFrozenGames\x12).gamehub.ratingext.ListFrozenGamesRequest\x1a*.gamehub.ratingext.ListFrozenGamesResponse\x12Z\n" +
+	"\x0eSetGameRelease\x12(.gamehub.ratingext.SetGameReleaseRequest\x1a\x1e.gamehub.ratingext.GameRelease\x12n\n" +
+	"\x11DeleteGameRelease\x12+.gamehub.ratingext.DeleteGameReleaseRequest\x1a,.gamehub.ratingext.DeleteGameReleaseResponse\x12w\n" +
+	"\x14ListUpcomingReleases\x12..gamehub.ratingext.ListUpcomingReleasesRequest\x1a/.gamehub.ratingext.ListUpcomingReleasesResponse\x12m\n" +
+	"\x15GrantEmbargoExemption\x12/.gamehub.ratingext.GrantEmbargoExemptionRequest\x1a#.gamehub.ratingext.EmbargoExemption\x12}\n" +
+	"\x16RevokeEmbargoExemption\x120.gamehub.ratingext.RevokeEmbargoExemptionRequest\x1a1.gamehub.ratingext.RevokeEmbargoExemptionResponse\x12z\n" +
+	"\x15ListEmbargoExemptions\x12/.gamehub.ratingext.ListEmbargoExemptionsRequest\x1a0.gamehub.ratingext.ListEmbargoExemptionsResponseBFZDgithub.com/RozmiDan/gameReviewHubRating/gen/go/ratingext;ratingextv1b\x06proto3"
+
+var (
+	file_ratingext_rating_admin_proto_rawDescOnce sync.Once
+	file_ratingext_rating_admin_proto_rawDescData []byte
+)
+
+func file_ratingext_rating_admin_proto_rawDescGZIP() []byte {
+	file_ratingext_rating_admin_proto_rawDescOnce.Do(func() {
+		file_ratingext_rating_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ratingext_rating_admin_proto_rawDesc), len(file_ratingext_rating_admin_proto_rawDesc)))
+	})
+	return file_ratingext_rating_admin_proto_rawDescData
+}
+
+var file_ratingext_rating_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_ratingext_rating_admin_proto_goTypes = []any{
+	(*ModerationTarget)(nil),               // 0: gamehub.ratingext.ModerationTarget
+	(*ModerateRatingsRequest)(nil),         // 1: gamehub.ratingext.ModerateRatingsRequest
+	(*ModerationAction)(nil),               // 2: gamehub.ratingext.ModerationAction
+	(*ListModerationActionsRequest)(nil),   // 3: gamehub.ratingext.ListModerationActionsRequest
+	(*ListModerationActionsResponse)(nil),  // 4: gamehub.ratingext.ListModerationActionsResponse
+	(*FreezeGameRequest)(nil),              // 5: gamehub.ratingext.FreezeGameRequest
+	(*UnfreezeGameRequest)(nil),            // 6: gamehub.ratingext.UnfreezeGameRequest
+	(*GameFreeze)(nil),                     // 7: gamehub.ratingext.GameFreeze
+	(*ListFrozenGamesRequest)(nil),         // 8: gamehub.ratingext.ListFrozenGamesRequest
+	(*ListFrozenGamesResponse)(nil),        // 9: gamehub.ratingext.ListFrozenGamesResponse
+	(*SetGameReleaseRequest)(nil),          // 10: gamehub.ratingext.SetGameReleaseRequest
+	(*GameRelease)(nil),                    // 11: gamehub.ratingext.GameRelease
+	(*DeleteGameReleaseRequest)(nil),       // 12: gamehub.ratingext.DeleteGameReleaseRequest
+	(*DeleteGameReleaseResponse)(nil),      // 13: gamehub.ratingext.DeleteGameReleaseResponse
+	(*ListUpcomingReleasesRequest)(nil),    // 14: gamehub.ratingext.ListUpcomingReleasesRequest
+	(*ListUpcomingReleasesResponse)(nil),   // 15: gamehub.ratingext.ListUpcomingReleasesResponse
+	(*GrantEmbargoExemptionRequest)(nil),   // 16: gamehub.ratingext.GrantEmbargoExemptionRequest
+	(*EmbargoExemption)(nil),               // 17: gamehub.ratingext.EmbargoExemption
+	(*RevokeEmbargoExemptionRequest)(nil),  // 18: gamehub.ratingext.RevokeEmbargoExemptionRequest
+	(*RevokeEmbargoExemptionResponse)(nil), // 19: gamehub.ratingext.RevokeEmbargoExemptionResponse
+	(*ListEmbargoExemptionsRequest)(nil),   // 20: gamehub.ratingext.ListEmbargoExemptionsRequest
+	(*ListEmbargoExemptionsResponse)(nil),  // 21: gamehub.ratingext.ListEmbargoExemptionsResponse
+	(*timestamppb.Timestamp)(nil),          // 22: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 23: google.protobuf.Duration
+}
+var file_ratingext_rating_admin_proto_depIdxs = []int32{
+	22, // 0: gamehub.ratingext.ModerationTarget.from:type_name -> google.protobuf.Timestamp
+	22, // 1: gamehub.ratingext.ModerationTarget.to:type_name -> google.protobuf.Timestamp
+	0,  // 2: gamehub.ratingext.ModerateRatingsRequest.target:type_name -> gamehub.ratingext.ModerationTarget
+	0,  // 3: gamehub.ratingext.ModerationAction.target:type_name -> gamehub.ratingext.ModerationTarget
+	22, // 4: gamehub.ratingext.ModerationAction.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: gamehub.ratingext.ListModerationActionsResponse.actions:type_name -> gamehub.ratingext.ModerationAction
+	23, // 6: gamehub.ratingext.FreezeGameRequest.ttl:type_name -> google.protobuf.Duration
+	22, // 7: gamehub.ratingext.GameFreeze.frozen_at:type_name -> google.protobuf.Timestamp
+	22, // 8: gamehub.ratingext.GameFreeze.expires_at:type_name -> google.protobuf.Timestamp
+	7,  // 9: gamehub.ratingext.ListFrozenGamesResponse.games:type_name -> gamehub.ratingext.GameFreeze
+	22, // 10: gamehub.ratingext.SetGameReleaseRequest.release_at:type_name -> google.protobuf.Timestamp
+	22, // 11: gamehub.ratingext.GameRelease.release_at:type_name -> google.protobuf.Timestamp
+	22, // 12: gamehub.ratingext.GameRelease.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 13: gamehub.ratingext.ListUpcomingReleasesResponse.games:type_name -> gamehub.ratingext.GameRelease
+	22, // 14: gamehub.ratingext.EmbargoExemption.created_at:type_name -> google.protobuf.Timestamp
+	17, // 15: gamehub.ratingext.ListEmbargoExemptionsResponse.users:type_name -> gamehub.ratingext.EmbargoExemption
+	1,  // 16: gamehub.ratingext.RatingAdminService.HideRatings:input_type -> gamehub.ratingext.ModerateRatingsRequest
+	1,  // 17: gamehub.ratingext.RatingAdminService.UnhideRatings:input_type -> gamehub.ratingext.ModerateRatingsRequest
+	3,  // 18: gamehub.ratingext.RatingAdminService.ListModerationActions:input_type -> gamehub.ratingext.ListModerationActionsRequest
+	5,  // 19: gamehub.ratingext.RatingAdminService.FreezeGame:input_type -> gamehub.ratingext.FreezeGameRequest
+	6,  // 20: gamehub.ratingext.RatingAdminService.UnfreezeGame:input_type -> gamehub.ratingext.UnfreezeGameRequest
+	8,  // 21: gamehub.ratingext.RatingAdminService.ListFrozenGames:input_type -> gamehub.ratingext.ListFrozenGamesRequest
+	10, // 22: gamehub.ratingext.RatingAdminService.SetGameRelease:input_type -> gamehub.ratingext.SetGameReleaseRequest
+	12, // 23: gamehub.ratingext.RatingAdminService.DeleteGameRelease:input_type -> gamehub.ratingext.DeleteGameReleaseRequest
+	14, // 24: gamehub.ratingext.RatingAdminService.ListUpcomingReleases:input_type -> gamehub.ratingext.ListUpcomingReleasesRequest
+	16, // 25: gamehub.ratingext.RatingAdminService.GrantEmbargoExemption:input_type -> gamehub.ratingext.GrantEmbargoExemptionRequest
+	18, // 26: gamehub.ratingext.RatingAdminService.RevokeEmbargoExemption:input_type -> gamehub.ratingext.RevokeEmbargoExemptionRequest
+	20, // 27: gamehub.ratingext.RatingAdminService.ListEmbargoExemptions:input_type -> gamehub.ratingext.ListEmbargoExemptionsRequest
+	2,  // 28: gamehub.ratingext.RatingAdminService.HideRatings:output_type -> gamehub.ratingext.ModerationAction
+	2,  // 29: gamehub.ratingext.RatingAdminService.UnhideRatings:output_type -> gamehub.ratingext.ModerationAction
+	4,  // 30: gamehub.ratingext.RatingAdminService.ListModerationActions:output_type -> gamehub.ratingext.ListModerationActionsResponse
+	7,  // 31: gamehub.ratingext.RatingAdminService.FreezeGame:output_type -> gamehub.ratingext.GameFreeze
+	7,  // 32: gamehub.ratingext.RatingAdminService.UnfreezeGame:output_type -> gamehub.ratingext.GameFreeze
+	9,  // 33: gamehub.ratingext.RatingAdminService.ListFrozenGames:output_type -> gamehub.ratingext.ListFrozenGamesResponse
+	11, // 34: gamehub.ratingext.RatingAdminService.SetGameRelease:output_type -> gamehub.ratingext.GameRelease
+	13, // 35: gamehub.ratingext.RatingAdminService.DeleteGameRelease:output_type -> gamehub.ratingext.DeleteGameReleaseResponse
+	15, // 36: gamehub.ratingext.RatingAdminService.ListUpcomingReleases:output_type -> gamehub.ratingext.ListUpcomingReleasesResponse
+	17, // 37: gamehub.ratingext.RatingAdminService.GrantEmbargoExemption:output_type -> gamehub.ratingext.EmbargoExemption
+	19, // 38: gamehub.ratingext.RatingAdminService.RevokeEmbargoExemption:output_type -> gamehub.ratingext.RevokeEmbargoExemptionResponse
+	21, // 39: gamehub.ratingext.RatingAdminService.ListEmbargoExemptions:output_type -> gamehub.ratingext.ListEmbargoExemptionsResponse
+	28, // [28:40] is the sub-list for method output_type
+	16, // [16:28] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_ratingext_rating_admin_proto_init() }
+func file_ratingext_rating_admin_proto_init() {
+	if File_ratingext_rating_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ratingext_rating_admin_proto_rawDesc), len(file_ratingext_rating_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ratingext_rating_admin_proto_goTypes,
+		DependencyIndexes: file_ratingext_rating_admin_proto_depIdxs,
+		MessageInfos:      file_ratingext_rating_admin_proto_msgTypes,
+	}.Build()
+	File_ratingext_rating_admin_proto = out.File
+	file_ratingext_rating_admin_proto_goTypes = nil
+	file_ratingext_rating_admin_proto_depIdxs = nil
+}
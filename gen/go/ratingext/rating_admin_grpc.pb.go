@@ -0,0 +1,585 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ratingext/rating_admin.proto
+
+package ratingextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RatingAdminService_HideRatings_FullMethodName            = "/gamehub.ratingext.RatingAdminService/HideRatings"
+	RatingAdminService_UnhideRatings_FullMethodName          = "/gamehub.ratingext.RatingAdminService/UnhideRatings"
+	RatingAdminService_ListModerationActions_FullMethodName  = "/gamehub.ratingext.RatingAdminService/ListModerationActions"
+	RatingAdminService_FreezeGame_FullMethodName             = "/gamehub.ratingext.RatingAdminService/FreezeGame"
+	RatingAdminService_UnfreezeGame_FullMethodName           = "/gamehub.ratingext.RatingAdminService/UnfreezeGame"
+	RatingAdminService_ListFrozenGames_FullMethodName        = "/gamehub.ratingext.RatingAdminService/ListFrozenGames"
+	RatingAdminService_SetGameRelease_FullMethodName         = "/gamehub.ratingext.RatingAdminService/SetGameRelease"
+	RatingAdminService_DeleteGameRelease_FullMethodName      = "/gamehub.ratingext.RatingAdminService/DeleteGameRelease"
+	RatingAdminService_ListUpcomingReleases_FullMethodName   = "/gamehub.ratingext.RatingAdminService/ListUpcomingReleases"
+	RatingAdminService_GrantEmbargoExemption_FullMethodName  = "/gamehub.ratingext.RatingAdminService/GrantEmbargoExemption"
+	RatingAdminService_RevokeEmbargoExemption_FullMethodName = "/gamehub.ratingext.RatingAdminService/RevokeEmbargoExemption"
+	RatingAdminService_ListEmbargoExemptions_FullMethodName  = "/gamehub.ratingext.RatingAdminService/ListEmbargoExemptions"
+)
+
+// RatingAdminServiceClient is the client API for RatingAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Операции модерации и обслуживания, недоступные обычным клиентам.
+type RatingAdminServiceClient interface {
+	// Скрывает оценки: они пропадают из агрегатов, топа, снимков и рекомендаций,
+	// но не удаляются. Повторная оценка пользователя остаётся скрытой.
+	HideRatings(ctx context.Context, in *ModerateRatingsRequest, opts ...grpc.CallOption) (*ModerationAction, error)
+	// Возвращает скрытые оценки в агрегаты.
+	UnhideRatings(ctx context.Context, in *ModerateRatingsRequest, opts ...grpc.CallOption) (*ModerationAction, error)
+	// Журнал модерации, новые действия первыми.
+	ListModerationActions(ctx context.Context, in *ListModerationActionsRequest, opts ...grpc.CallOption) (*ListModerationActionsResponse, error)
+	// Замораживает публичный рейтинг игры: GetGameRating и топ отдают снимок на момент
+	// заморозки (с признаком frozen в gamehub.ratingext.RatingService), SubmitRating
+	// продолжает принимать оценки.
+	// Повторный вызов для замороженной игры меняет срок и причину, снимок остаётся прежним.
+	// Игра без оценок - NOT_FOUND.
+	FreezeGame(ctx context.Context, in *FreezeGameRequest, opts ...grpc.CallOption) (*GameFreeze, error)
+	// Снимает заморозку: рейтинг сразу учитывает всё накопленное. FAILED_PRECONDITION,
+	// если игра не заморожена.
+	UnfreezeGame(ctx context.Context, in *UnfreezeGameRequest, opts ...grpc.CallOption) (*GameFreeze, error)
+	// Действующие заморозки, самые свежие первыми.
+	ListFrozenGames(ctx context.Context, in *ListFrozenGamesRequest, opts ...grpc.CallOption) (*ListFrozenGamesResponse, error)
+	// Задаёт дату выхода игры. До неё SubmitRating отклоняет оценки с FAILED_PRECONDITION
+	// (причина GAME_NOT_RELEASED, в метаданных release_at) или откладывает их до выхода -
+	// кроме пользователей из списка исключений (GrantEmbargoExemption).
+	SetGameRelease(ctx context.Context, in *SetGameReleaseRequest, opts ...grpc.CallOption) (*GameRelease, error)
+	// Снимает эмбарго; отложенные оценки попадают в рейтинг.
+	DeleteGameRelease(ctx context.Context, in *DeleteGameReleaseRequest, opts ...grpc.CallOption) (*DeleteGameReleaseResponse, error)
+	// Игры, которые ещё не вышли, ближайшие первыми.
+	ListUpcomingReleases(ctx context.Context, in *ListUpcomingReleasesRequest, opts ...grpc.CallOption) (*ListUpcomingReleasesResponse, error)
+	// Разрешает пользователю (прессе, обозревателю) оценивать игры до выхода.
+	// Повторный вызов меняет granted_by и причину.
+	GrantEmbargoExemption(ctx context.Context, in *GrantEmbargoExemptionRequest, opts ...grpc.CallOption) (*EmbargoExemption, error)
+	// Убирает пользователя из списка исключений. NOT_FOUND, если его там нет.
+	RevokeEmbargoExemption(ctx context.Context, in *RevokeEmbargoExemptionRequest, opts ...grpc.CallOption) (*RevokeEmbargoExemptionResponse, error)
+	// Пользователи из списка исключений, новые первыми.
+	ListEmbargoExemptions(ctx context.Context, in *ListEmbargoExemptionsRequest, opts ...grpc.CallOption) (*ListEmbargoExemptionsResponse, error)
+}
+
+type ratingAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingAdminServiceClient(cc grpc.ClientConnInterface) RatingAdminServiceClient {
+	return &ratingAdminServiceClient{cc}
+}
+
+func (c *ratingAdminServiceClient) HideRatings(ctx context.Context, in *ModerateRatingsRequest, opts ...grpc.CallOption) (*ModerationAction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationAction)
+	err := c.cc.Invoke(ctx, RatingAdminService_HideRatings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingAdminServiceClient) UnhideRatings(ctx context.Context, in *ModerateRatingsRequest, opts ...grpc.CallOption) (*ModerationAction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationAction)
+	err := c.cc.Invoke(ctx, RatingAdminService_UnhideRatings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingAdminServiceClient) ListModerationActions(ctx context.Context, in *ListModerationActionsRequest, opts ...grpc.CallOption) (*ListModerationActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationActionsResponse)
+	err := c.cc.Invoke(ctx, RatingAdminService_ListModerationActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingAdminServiceClient) FreezeGame(ctx context.Context, in *FreezeGameRequest, opts ...grpc.CallOption) (*GameFreeze, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameFreeze)
+	err := c.cc.Invoke(ctx, RatingAdminService_FreezeGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingAdminServiceClient) UnfreezeGame(ctx context.Context, in *UnfreezeGameRequest, opts ...grpc.CallOption) (*GameFreeze, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameFreeze)
+	err := c.cc.Invoke(ctx, RatingAdminService_UnfreezeGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingAdminServiceClient) ListFrozenGames(ctx context.Context, in *ListFrozenGamesRequest, opts ...grpc.CallOption) (*ListFrozenGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFrozenGamesResponse)
+	err := c.cc.Invoke(ctx, RatingAdminService_ListFrozenGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingAdminServiceClient) SetGameRelease(ctx context.Context, in *SetGameReleaseRequest, opts ...grpc.CallOption) (*GameRelease, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameRelease)
+	err := c.cc.Invoke(ctx, RatingAdminService_SetGameRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingAdminServiceClient) DeleteGameRelease(ctx context.Context, in *DeleteGameReleaseRequest, opts ...grpc.CallOption) (*DeleteGameReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGameReleaseResponse)
+	err := c.cc.Invoke(ctx, RatingAdminService_DeleteGameRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingAdminServiceClient) ListUpcomingReleases(ctx context.Context, in *ListUpcomingReleasesRequest, opts ...grpc.CallOption) (*ListUpcomingReleasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUpcomingReleasesResponse)
+	err := c.cc.Invoke(ctx, RatingAdminService_ListUpcomingReleases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingAdminServiceClient) GrantEmbargoExemption(ctx context.Context, in *GrantEmbargoExemptionRequest, opts ...grpc.CallOption) (*EmbargoExemption, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbargoExemption)
+	err := c.cc.Invoke(ctx, RatingAdminService_GrantEmbargoExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingAdminServiceClient) RevokeEmbargoExemption(ctx context.Context, in *RevokeEmbargoExemptionRequest, opts ...grpc.CallOption) (*RevokeEmbargoExemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeEmbargoExemptionResponse)
+	err := c.cc.Invoke(ctx, RatingAdminService_RevokeEmbargoExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingAdminServiceClient) ListEmbargoExemptions(ctx context.Context, in *ListEmbargoExemptionsRequest, opts ...grpc.CallOption) (*ListEmbargoExemptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmbargoExemptionsResponse)
+	err := c.cc.Invoke(ctx, RatingAdminService_ListEmbargoExemptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatingAdminServiceServer is the server API for RatingAdminService service.
+// All implementations must embed UnimplementedRatingAdminServiceServer
+// for forward compatibility.
+//
+// Операции модерации и обслуживания, недоступные обычным клиентам.
+type RatingAdminServiceServer interface {
+	// Скрывает оценки: они пропадают из агрегатов, топа, снимков и рекомендаций,
+	// но не удаляются. Повторная оценка пользователя остаётся скрытой.
+	HideRatings(context.Context, *ModerateRatingsRequest) (*ModerationAction, error)
+	// Возвращает скрытые оценки в агрегаты.
+	UnhideRatings(context.Context, *ModerateRatingsRequest) (*ModerationAction, error)
+	// Журнал модерации, новые действия первыми.
+	ListModerationActions(context.Context, *ListModerationActionsRequest) (*ListModerationActionsResponse, error)
+	// Замораживает публичный рейтинг игры: GetGameRating и топ отдают снимок на момент
+	// заморозки (с признаком frozen в gamehub.ratingext.RatingService), SubmitRating
+	// продолжает принимать оценки.
+	// Повторный вызов для замороженной игры меняет срок и причину, снимок остаётся прежним.
+	// Игра без оценок - NOT_FOUND.
+	FreezeGame(context.Context, *FreezeGameRequest) (*GameFreeze, error)
+	// Снимает заморозку: рейтинг сразу учитывает всё накопленное. FAILED_PRECONDITION,
+	// если игра не заморожена.
+	UnfreezeGame(context.Context, *UnfreezeGameRequest) (*GameFreeze, error)
+	// Действующие заморозки, самые свежие первыми.
+	ListFrozenGames(context.Context, *ListFrozenGamesRequest) (*ListFrozenGamesResponse, error)
+	// Задаёт дату выхода игры. До неё SubmitRating отклоняет оценки с FAILED_PRECONDITION
+	// (причина GAME_NOT_RELEASED, в метаданных release_at) или откладывает их до выхода -
+	// кроме пользователей из списка исключений (GrantEmbargoExemption).
+	SetGameRelease(context.Context, *SetGameReleaseRequest) (*GameRelease, error)
+	// Снимает эмбарго; отложенные оценки попадают в рейтинг.
+	DeleteGameRelease(context.Context, *DeleteGameReleaseRequest) (*DeleteGameReleaseResponse, error)
+	// Игры, которые ещё не вышли, ближайшие первыми.
+	ListUpcomingReleases(context.Context, *ListUpcomingReleasesRequest) (*ListUpcomingReleasesResponse, error)
+	// Разрешает пользователю (прессе, обозревателю) оценивать игры до выхода.
+	// Повторный вызов меняет granted_by и причину.
+	GrantEmbargoExemption(context.Context, *GrantEmbargoExemptionRequest) (*EmbargoExemption, error)
+	// Убирает пользователя из списка исключений. NOT_FOUND, если его там нет.
+	RevokeEmbargoExemption(context.Context, *RevokeEmbargoExemptionRequest) (*RevokeEmbargoExemptionResponse, error)
+	// Пользователи из списка исключений, новые первыми.
+	ListEmbargoExemptions(context.Context, *ListEmbargoExemptionsRequest) (*ListEmbargoExemptionsResponse, error)
+	mustEmbedUnimplementedRatingAdminServiceServer()
+}
+
+// UnimplementedRatingAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatingAdminServiceServer struct{}
+
+func (UnimplementedRatingAdminServiceServer) HideRatings(context.Context, *ModerateRatingsRequest) (*ModerationAction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HideRatings not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) UnhideRatings(context.Context, *ModerateRatingsRequest) (*ModerationAction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnhideRatings not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) ListModerationActions(context.Context, *ListModerationActionsRequest) (*ListModerationActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationActions not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) FreezeGame(context.Context, *FreezeGameRequest) (*GameFreeze, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeGame not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) UnfreezeGame(context.Context, *UnfreezeGameRequest) (*GameFreeze, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeGame not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) ListFrozenGames(context.Context, *ListFrozenGamesRequest) (*ListFrozenGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFrozenGames not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) SetGameRelease(context.Context, *SetGameReleaseRequest) (*GameRelease, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGameRelease not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) DeleteGameRelease(context.Context, *DeleteGameReleaseRequest) (*DeleteGameReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGameRelease not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) ListUpcomingReleases(context.Context, *ListUpcomingReleasesRequest) (*ListUpcomingReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUpcomingReleases not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) GrantEmbargoExemption(context.Context, *GrantEmbargoExemptionRequest) (*EmbargoExemption, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantEmbargoExemption not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) RevokeEmbargoExemption(context.Context, *RevokeEmbargoExemptionRequest) (*RevokeEmbargoExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeEmbargoExemption not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) ListEmbargoExemptions(context.Context, *ListEmbargoExemptionsRequest) (*ListEmbargoExemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmbargoExemptions not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) mustEmbedUnimplementedRatingAdminServiceServer() {}
+func (UnimplementedRatingAdminServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeRatingAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingAdminServiceServer will
+// result in compilation errors.
+type UnsafeRatingAdminServiceServer interface {
+	mustEmbedUnimplementedRatingAdminServiceServer()
+}
+
+func RegisterRatingAdminServiceServer(s grpc.ServiceRegistrar, srv RatingAdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRatingAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatingAdminService_ServiceDesc, srv)
+}
+
+func _RatingAdminService_HideRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).HideRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_HideRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).HideRatings(ctx, req.(*ModerateRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingAdminService_UnhideRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).UnhideRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_UnhideRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).UnhideRatings(ctx, req.(*ModerateRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingAdminService_ListModerationActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).ListModerationActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_ListModerationActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).ListModerationActions(ctx, req.(*ListModerationActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingAdminService_FreezeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).FreezeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_FreezeGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).FreezeGame(ctx, req.(*FreezeGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingAdminService_UnfreezeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).UnfreezeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_UnfreezeGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).UnfreezeGame(ctx, req.(*UnfreezeGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingAdminService_ListFrozenGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFrozenGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).ListFrozenGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_ListFrozenGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).ListFrozenGames(ctx, req.(*ListFrozenGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingAdminService_SetGameRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGameReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).SetGameRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_SetGameRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).SetGameRelease(ctx, req.(*SetGameReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingAdminService_DeleteGameRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGameReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).DeleteGameRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_DeleteGameRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).DeleteGameRelease(ctx, req.(*DeleteGameReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingAdminService_ListUpcomingReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUpcomingReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).ListUpcomingReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_ListUpcomingReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).ListUpcomingReleases(ctx, req.(*ListUpcomingReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingAdminService_GrantEmbargoExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantEmbargoExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).GrantEmbargoExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_GrantEmbargoExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).GrantEmbargoExemption(ctx, req.(*GrantEmbargoExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingAdminService_RevokeEmbargoExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeEmbargoExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).RevokeEmbargoExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_RevokeEmbargoExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).RevokeEmbargoExemption(ctx, req.(*RevokeEmbargoExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingAdminService_ListEmbargoExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmbargoExemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).ListEmbargoExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingAdminService_ListEmbargoExemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).ListEmbargoExemptions(ctx, req.(*ListEmbargoExemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatingAdminService_ServiceDesc is the grpc.ServiceDesc for RatingAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatingAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gamehub.ratingext.RatingAdminService",
+	HandlerType: (*RatingAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HideRatings",
+			Handler:    _RatingAdminService_HideRatings_Handler,
+		},
+		{
+			MethodName: "UnhideRatings",
+			Handler:    _RatingAdminService_UnhideRatings_Handler,
+		},
+		{
+			MethodName: "ListModerationActions",
+			Handler:    _RatingAdminService_ListModerationActions_Handler,
+		},
+		{
+			MethodName: "FreezeGame",
+			Handler:    _RatingAdminService_FreezeGame_Handler,
+		},
+		{
+			MethodName: "UnfreezeGame",
+			Handler:    _RatingAdminService_UnfreezeGame_Handler,
+		},
+		{
+			MethodName: "ListFrozenGames",
+			Handler:    _RatingAdminService_ListFrozenGames_Handler,
+		},
+		{
+			MethodName: "SetGameRelease",
+			Handler:    _RatingAdminService_SetGameRelease_Handler,
+		},
+		{
+			MethodName: "DeleteGameRelease",
+			Handler:    _RatingAdminService_DeleteGameRelease_Handler,
+		},
+		{
+			MethodName: "ListUpcomingReleases",
+			Handler:    _RatingAdminService_ListUpcomingReleases_Handler,
+		},
+		{
+			MethodName: "GrantEmbargoExemption",
+			Handler:    _RatingAdminService_GrantEmbargoExemption_Handler,
+		},
+		{
+			MethodName: "RevokeEmbargoExemption",
+			Handler:    _RatingAdminService_RevokeEmbargoExemption_Handler,
+		},
+		{
+			MethodName: "ListEmbargoExemptions",
+			Handler:    _RatingAdminService_ListEmbargoExemptions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ratingext/rating_admin.proto",
+}
@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ratingext/rating_compare.proto
+
+package ratingextv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CompareGamesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// от 2 до compare.max_games из конфига, без повторов
+	GameIds []string `protobuf:"bytes,1,rep,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"`
+	// шкала, на которую проецируются средние; пусто - каноническая 1-10
+	Scale         string `protobuf:"bytes,2,opt,name=scale,proto3" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareGamesRequest) Reset() {
+	*x = CompareGamesRequest{}
+	mi := &file_ratingext_rating_compare_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareGamesRequest) ProtoMessage() {}
+
+func (x *CompareGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_compare_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareGamesRequest.ProtoReflect.Descriptor instead.
+func (*CompareGamesRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_compare_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CompareGamesRequest) GetGameIds() []string {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+func (x *CompareGamesRequest) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+type GameComparison struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// frozen - только game_id, average_rating, ratings_count и rank, из снимка заморозки
+	GameId        string  `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	AverageRating float64 `protobuf:"fixed64,2,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	RatingsCount  int64   `protobuf:"varint,3,opt,name=ratings_count,json=ratingsCount,proto3" json:"ratings_count,omitempty"`
+	// distribution[i] - число оценок со score, округлённым до i+1 на шкале 1-10
+	Distribution []int64 `protobuf:"varint,4,rep,packed,name=distribution,proto3" json:"distribution,omitempty"`
+	// место в топе по среднему (как в GetTopGames); 0 - игры в топе нет
+	Rank int64 `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	// доверительный интервал среднего уровня confidence; при одной оценке равен среднему
+	CiLow         float64 `protobuf:"fixed64,6,opt,name=ci_low,json=ciLow,proto3" json:"ci_low,omitempty"`
+	CiHigh        float64 `protobuf:"fixed64,7,opt,name=ci_high,json=ciHigh,proto3" json:"ci_high,omitempty"`
+	Frozen        bool    `protobuf:"varint,8,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameComparison) Reset() {
+	*x = GameComparison{}
+	mi := &file_ratingext_rating_compare_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameComparison) ProtoMessage() {}
+
+func (x *GameComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_compare_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameComparison.ProtoReflect.Descriptor instead.
+func (*GameComparison) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_compare_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GameComparison) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameComparison) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *GameComparison) GetRatingsCount() int64 {
+	if x != nil {
+		return x.RatingsCount
+	}
+	return 0
+}
+
+func (x *GameComparison) GetDistribution() []int64 {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+func (x *GameComparison) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GameComparison) GetCiLow() float64 {
+	if x != nil {
+		return x.CiLow
+	}
+	return 0
+}
+
+func (x *GameComparison) GetCiHigh() float64 {
+	if x != nil {
+		return x.CiHigh
+	}
+	return 0
+}
+
+func (x *GameComparison) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+type PairComparison struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	GameA string                 `protobuf:"bytes,1,opt,name=game_a,json=gameA,proto3" json:"game_a,omitempty"`
+	GameB string                 `protobuf:"bytes,2,opt,name=game_b,json=gameB,proto3" json:"game_b,omitempty"`
+	// среднее A минус среднее B
+	MeanDifference float64 `protobuf:"fixed64,3,opt,name=mean_difference,json=meanDifference,proto3" json:"mean_difference,omitempty"`
+	// U-статистика для A и её z в нормальном приближении
+	U float64 `protobuf:"fixed64,4,opt,name=u,proto3" json:"u,omitempty"`
+	Z float64 `protobuf:"fixed64,5,opt,name=z,proto3" json:"z,omitempty"`
+	// вероятность, что случайная оценка A выше случайной оценки B (ничьи - пополам)
+	ProbSuperiority float64 `protobuf:"fixed64,6,opt,name=prob_superiority,json=probSuperiority,proto3" json:"prob_superiority,omitempty"`
+	PValue          float64 `protobuf:"fixed64,7,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+	PAdjusted       float64 `protobuf:"fixed64,8,opt,name=p_adjusted,json=pAdjusted,proto3" json:"p_adjusted,omitempty"`
+	// p_adjusted < alpha
+	Significant   bool `protobuf:"varint,9,opt,name=significant,proto3" json:"significant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PairComparison) Reset() {
+	*x = PairComparison{}
+	mi := &file_ratingext_rating_compare_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PairComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairComparison) ProtoMessage() {}
+
+func (x *PairComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_compare_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairComparison.ProtoReflect.Descriptor instead.
+func (*PairComparison) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_compare_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PairComparison) GetGameA() string {
+	if x != nil {
+		return x.GameA
+	}
+	return ""
+}
+
+func (x *PairComparison) GetGameB() string {
+	if x != nil {
+		return x.GameB
+	}
+	return ""
+}
+
+func (x *PairComparison) GetMeanDifference() float64 {
+	if x != nil {
+		return x.MeanDifference
+	}
+	return 0
+}
+
+func (x *PairComparison) GetU() float64 {
+	if x != nil {
+		return x.U
+	}
+	return 0
+}
+
+func (x *PairComparison) GetZ() float64 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *PairComparison) GetProbSuperiority() float64 {
+	if x != nil {
+		return x.ProbSuperiority
+	}
+	return 0
+}
+
+func (x *PairComparison) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *PairComparison) GetPAdjusted() float64 {
+	if x != nil {
+		return x.PAdjusted
+	}
+	return 0
+}
+
+func (x *PairComparison) GetSignificant() bool {
+	if x != nil {
+		return x.Significant
+	}
+	return false
+}
+
+type CompareGamesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Scale string                 `protobuf:"bytes,1,opt,name=scale,proto3" json:"scale,omitempty"`
+	// в порядке запроса
+	Games []*GameComparison `protobuf:"bytes,2,rep,name=games,proto3" json:"games,omitempty"`
+	// все пары (i, j), i < j, незамороженных игр в порядке запроса
+	Pairs         []*PairComparison `protobuf:"bytes,3,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	Confidence    float64           `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Alpha         float64           `protobuf:"fixed64,5,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareGamesResponse) Reset() {
+	*x = CompareGamesResponse{}
+	mi := &file_ratingext_rating_compare_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareGamesResponse) ProtoMessage() {}
+
+func (x *CompareGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_compare_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareGamesResponse.ProtoReflect.Descriptor instead.
+func (*CompareGamesResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_compare_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CompareGamesResponse) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+func (x *CompareGamesResponse) GetGames() []*GameComparison {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *CompareGamesResponse) GetPairs() []*PairComparison {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *CompareGamesResponse) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *CompareGamesResponse) GetAlpha() float64 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+var File_ratingext_rating_compare_proto protoreflect.FileDescriptor
+
+const file_ratingext_rating_compare_proto_rawDesc = "" +
+	"\n" +
+	"\x1eratingext/rating_compare.proto\x12\x11gamehub.ratingext\"F\n" +
+	"\x13CompareGamesRequest\x12\x19\n" +
+	"\bgame_ids\x18\x01 \x03(\tR\agameIds\x12\x14\n" +
+	"\x05scale\x18\x02 \x01(\tR\x05scale\"\xf5\x01\n" +
+	"\x0eGameComparison\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12%\n" +
+	"\x0eaverage_rating\x18\x02 \x01(\x01R\raverageRating\x12#\n" +
+	"\rratings_count\x18\x03 \x01(\x03R\fratingsCount\x12\"\n" +
+	"\fdistribution\x18\x04 \x03(\x03R\fdistribution\x12\x12\n" +
+	"\x04rank\x18\x05 \x01(\x03R\x04rank\x12\x15\n" +
+	"\x06ci_low\x18\x06 \x01(\x01R\x05ciLow\x12\x17\n" +
+	"\aci_high\x18\a \x01(\x01R\x06ciHigh\x12\x16\n" +
+	"\x06frozen\x18\b \x01(\bR\x06frozen\"\x88\x02\n" +
+	"\x0ePairComparison\x12\x15\n" +
+	"\x06game_a\x18\x01 \x01(\tR\x05gameA\x12\x15\n" +
+	"\x06game_b\x18\x02 \x01(\tR\x05gameB\x12'\n" +
+	"\x0fmean_difference\x18\x03 \x01(\x01R\x0emeanDifference\x12\f\n" +
+	"\x01u\x18\x04 \x01(\x01R\x01u\x12\f\n" +
+	"\x01z\x18\x05 \x01(\x01R\x01z\x12)\n" +
+	"\x10prob_superiority\x18\x06 \x01(\x01R\x0fprobSuperiority\x12\x17\n" +
+	"\ap_value\x18\a \x01(\x01R\x06pValue\x12\x1d\n" +
+	"\n" +
+	"p_adjusted\x18\b \x01(\x01R\tpAdjusted\x12 \n" +
+	"\vsignificant\x18\t \x01(\bR\vsignificant\"\xd4\x01\n" +
+	"\x14CompareGamesResponse\x12\x14\n" +
+	"\x05scale\x18\x01 \x01(\tR\x05scale\x127\n" +
+	"\x05games\x18\x02 \x03(\v2!.gamehub.ratingext.GameComparisonR\x05games\x127\n" +
+	"\x05pairs\x18\x03 \x03(\v2!.gamehub.ratingext.PairComparisonR\x05pairs\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\x01R\n" +
+	"confidence\x12\x14\n" +
+	"\x05alpha\x18\x05 \x01(\x01R\x05alpha2w\n" +
+	"\x14RatingCompareService\x12_\n" +
+	"\fCompareGames\x12&.gamehub.ratingext.CompareGamesRequest\x1a'.gamehub.ratingext.CompareGamesResponseBFZDgithub.com/RozmiDan/gameReviewHubRating/gen/go/ratingext;ratingextv1b\x06proto3"
+
+var (
+	file_ratingext_rating_compare_proto_rawDescOnce sync.Once
+	file_ratingext_rating_compare_proto_rawDescData []byte
+)
+
+func file_ratingext_rating_compare_proto_rawDescGZIP() []byte {
+	file_ratingext_rating_compare_proto_rawDescOnce.Do(func() {
+		file_ratingext_rating_compare_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ratingext_rating_compare_proto_rawDesc), len(file_ratingext_rating_compare_proto_rawDesc)))
+	})
+	return file_ratingext_rating_compare_proto_rawDescData
+}
+
+var file_ratingext_rating_compare_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ratingext_rating_compare_proto_goTypes = []any{
+	(*CompareGamesRequest)(nil),  // 0: gamehub.ratingext.CompareGamesRequest
+	(*GameComparison)(nil),       // 1: gamehub.ratingext.GameComparison
+	(*PairComparison)(nil),       // 2: gamehub.ratingext.PairComparison
+	(*CompareGamesResponse)(nil), // 3: gamehub.ratingext.CompareGamesResponse
+}
+var file_ratingext_rating_compare_proto_depIdxs = []int32{
+	1, // 0: gamehub.ratingext.CompareGamesResponse.games:type_name -> gamehub.ratingext.GameComparison
+	2, // 1: gamehub.ratingext.CompareGamesResponse.pairs:type_name -> gamehub.ratingext.PairComparison
+	0, // 2: gamehub.ratingext.RatingCompareService.CompareGames:input_type -> gamehub.ratingext.CompareGamesRequest
+	3, // 3: gamehub.ratingext.RatingCompareService.CompareGames:output_type -> gamehub.ratingext.CompareGamesResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ratingext_rating_compare_proto_init() }
+func file_ratingext_rating_compare_proto_init() {
+	if File_ratingext_rating_compare_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ratingext_rating_compare_proto_rawDesc), len(file_ratingext_rating_compare_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ratingext_rating_compare_proto_goTypes,
+		DependencyIndexes: file_ratingext_rating_compare_proto_depIdxs,
+		MessageInfos:      file_ratingext_rating_compare_proto_msgTypes,
+	}.Build()
+	File_ratingext_rating_compare_proto = out.File
+	file_ratingext_rating_compare_proto_goTypes = nil
+	file_ratingext_rating_compare_proto_depIdxs = nil
+}
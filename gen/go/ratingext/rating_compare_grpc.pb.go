@@ -0,0 +1,129 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ratingext/rating_compare.proto
+
+package ratingextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RatingCompareService_CompareGames_FullMethodName = "/gamehub.ratingext.RatingCompareService/CompareGames"
+)
+
+// RatingCompareServiceClient is the client API for RatingCompareService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatingCompareServiceClient interface {
+	// Сравнение игр по оценкам для страниц "X vs Y": сводка по каждой игре и попарная
+	// значимость различий (U-критерий Манна-Уитни, p-value с поправкой Холма на число пар).
+	// Если у какой-то игры нет оценок - NOT_FOUND. Замороженные игры отдаются по снимку
+	// (frozen) и в пары не входят.
+	CompareGames(ctx context.Context, in *CompareGamesRequest, opts ...grpc.CallOption) (*CompareGamesResponse, error)
+}
+
+type ratingCompareServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingCompareServiceClient(cc grpc.ClientConnInterface) RatingCompareServiceClient {
+	return &ratingCompareServiceClient{cc}
+}
+
+func (c *ratingCompareServiceClient) CompareGames(ctx context.Context, in *CompareGamesRequest, opts ...grpc.CallOption) (*CompareGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareGamesResponse)
+	err := c.cc.Invoke(ctx, RatingCompareService_CompareGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatingCompareServiceServer is the server API for RatingCompareService service.
+// All implementations must embed UnimplementedRatingCompareServiceServer
+// for forward compatibility.
+type RatingCompareServiceServer interface {
+	// Сравнение игр по оценкам для страниц "X vs Y": сводка по каждой игре и попарная
+	// значимость различий (U-критерий Манна-Уитни, p-value с поправкой Холма на число пар).
+	// Если у какой-то игры нет оценок - NOT_FOUND. Замороженные игры отдаются по снимку
+	// (frozen) и в пары не входят.
+	CompareGames(context.Context, *CompareGamesRequest) (*CompareGamesResponse, error)
+	mustEmbedUnimplementedRatingCompareServiceServer()
+}
+
+// UnimplementedRatingCompareServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatingCompareServiceServer struct{}
+
+func (UnimplementedRatingCompareServiceServer) CompareGames(context.Context, *CompareGamesRequest) (*CompareGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareGames not implemented")
+}
+func (UnimplementedRatingCompareServiceServer) mustEmbedUnimplementedRatingCompareServiceServer() {}
+func (UnimplementedRatingCompareServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeRatingCompareServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingCompareServiceServer will
+// result in compilation errors.
+type UnsafeRatingCompareServiceServer interface {
+	mustEmbedUnimplementedRatingCompareServiceServer()
+}
+
+func RegisterRatingCompareServiceServer(s grpc.ServiceRegistrar, srv RatingCompareServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRatingCompareServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatingCompareService_ServiceDesc, srv)
+}
+
+func _RatingCompareService_CompareGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingCompareServiceServer).CompareGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingCompareService_CompareGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingCompareServiceServer).CompareGames(ctx, req.(*CompareGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatingCompareService_ServiceDesc is the grpc.ServiceDesc for RatingCompareService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatingCompareService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gamehub.ratingext.RatingCompareService",
+	HandlerType: (*RatingCompareServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CompareGames",
+			Handler:    _RatingCompareService_CompareGames_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ratingext/rating_compare.proto",
+}
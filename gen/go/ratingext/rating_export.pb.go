@@ -0,0 +1,352 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ratingext/rating_export.proto
+
+package ratingextv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0 // CSV
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_JSONL       ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_PARQUET     ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_JSONL",
+		3: "EXPORT_FORMAT_PARQUET",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_JSONL":       2,
+		"EXPORT_FORMAT_PARQUET":     3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_ratingext_rating_export_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_ratingext_rating_export_proto_enumTypes[0]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_ratingext_rating_export_proto_rawDescGZIP(), []int{0}
+}
+
+type Dataset int32
+
+const (
+	Dataset_DATASET_UNSPECIFIED  Dataset = 0
+	Dataset_DATASET_RATINGS      Dataset = 1
+	Dataset_DATASET_GAME_RATINGS Dataset = 2
+)
+
+// Enum value maps for Dataset.
+var (
+	Dataset_name = map[int32]string{
+		0: "DATASET_UNSPECIFIED",
+		1: "DATASET_RATINGS",
+		2: "DATASET_GAME_RATINGS",
+	}
+	Dataset_value = map[string]int32{
+		"DATASET_UNSPECIFIED":  0,
+		"DATASET_RATINGS":      1,
+		"DATASET_GAME_RATINGS": 2,
+	}
+)
+
+func (x Dataset) Enum() *Dataset {
+	p := new(Dataset)
+	*p = x
+	return p
+}
+
+func (x Dataset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Dataset) Descriptor() protoreflect.EnumDescriptor {
+	return file_ratingext_rating_export_proto_enumTypes[1].Descriptor()
+}
+
+func (Dataset) Type() protoreflect.EnumType {
+	return &file_ratingext_rating_export_proto_enumTypes[1]
+}
+
+func (x Dataset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Dataset.Descriptor instead.
+func (Dataset) EnumDescriptor() ([]byte, []int) {
+	return file_ratingext_rating_export_proto_rawDescGZIP(), []int{1}
+}
+
+type ExportRatingsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format ExportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=gamehub.ratingext.ExportFormat" json:"format,omitempty"`
+	// пусто - оба набора
+	Datasets []Dataset `protobuf:"varint,2,rep,packed,name=datasets,proto3,enum=gamehub.ratingext.Dataset" json:"datasets,omitempty"`
+	// полуинтервал [from, to) по ratings.created_at
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// пусто - все игры
+	GameIds       []string `protobuf:"bytes,5,rep,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRatingsRequest) Reset() {
+	*x = ExportRatingsRequest{}
+	mi := &file_ratingext_rating_export_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRatingsRequest) ProtoMessage() {}
+
+func (x *ExportRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_export_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRatingsRequest.ProtoReflect.Descriptor instead.
+func (*ExportRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_export_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportRatingsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportRatingsRequest) GetDatasets() []Dataset {
+	if x != nil {
+		return x.Datasets
+	}
+	return nil
+}
+
+func (x *ExportRatingsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportRatingsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ExportRatingsRequest) GetGameIds() []string {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+type ExportChunk struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Dataset Dataset                `protobuf:"varint,1,opt,name=dataset,proto3,enum=gamehub.ratingext.Dataset" json:"dataset,omitempty"`
+	Data    []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// последний чанк набора данных
+	Last          bool `protobuf:"varint,3,opt,name=last,proto3" json:"last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_ratingext_rating_export_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_export_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_export_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportChunk) GetDataset() Dataset {
+	if x != nil {
+		return x.Dataset
+	}
+	return Dataset_DATASET_UNSPECIFIED
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportChunk) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+var File_ratingext_rating_export_proto protoreflect.FileDescriptor
+
+const file_ratingext_rating_export_proto_rawDesc = "" +
+	"\n" +
+	"\x1dratingext/rating_export.proto\x12\x11gamehub.ratingext\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfe\x01\n" +
+	"\x14ExportRatingsRequest\x127\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1f.gamehub.ratingext.ExportFormatR\x06format\x126\n" +
+	"\bdatasets\x18\x02 \x03(\x0e2\x1a.gamehub.ratingext.DatasetR\bdatasets\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x19\n" +
+	"\bgame_ids\x18\x05 \x03(\tR\agameIds\"k\n" +
+	"\vExportChunk\x124\n" +
+	"\adataset\x18\x01 \x01(\x0e2\x1a.gamehub.ratingext.DatasetR\adataset\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x12\n" +
+	"\x04last\x18\x03 \x01(\bR\x04last*x\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x17\n" +
+	"\x13EXPORT_FORMAT_JSONL\x10\x02\x12\x19\n" +
+	"\x15EXPORT_FORMAT_PARQUET\x10\x03*Q\n" +
+	"\aDataset\x12\x17\n" +
+	"\x13DATASET_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fDATASET_RATINGS\x10\x01\x12\x18\n" +
+	"\x14DATASET_GAME_RATINGS\x10\x022q\n" +
+	"\x13RatingExportService\x12Z\n" +
+	"\rExportRatings\x12'.gamehub.ratingext.ExportRatingsRequest\x1a\x1e.gamehub.ratingext.ExportChunk0\x01BFZDgithub.com/RozmiDan/gameReviewHubRating/gen/go/ratingext;ratingextv1b\x06proto3"
+
+var (
+	file_ratingext_rating_export_proto_rawDescOnce sync.Once
+	file_ratingext_rating_export_proto_rawDescData []byte
+)
+
+func file_ratingext_rating_export_proto_rawDescGZIP() []byte {
+	file_ratingext_rating_export_proto_rawDescOnce.Do(func() {
+		file_ratingext_rating_export_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ratingext_rating_export_proto_rawDesc), len(file_ratingext_rating_export_proto_rawDesc)))
+	})
+	return file_ratingext_rating_export_proto_rawDescData
+}
+
+var file_ratingext_rating_export_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ratingext_rating_export_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ratingext_rating_export_proto_goTypes = []any{
+	(ExportFormat)(0),             // 0: gamehub.ratingext.ExportFormat
+	(Dataset)(0),                  // 1: gamehub.ratingext.Dataset
+	(*ExportRatingsRequest)(nil),  // 2: gamehub.ratingext.ExportRatingsRequest
+	(*ExportChunk)(nil),           // 3: gamehub.ratingext.ExportChunk
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_ratingext_rating_export_proto_depIdxs = []int32{
+	0, // 0: gamehub.ratingext.ExportRatingsRequest.format:type_name -> gamehub.ratingext.ExportFormat
+	1, // 1: gamehub.ratingext.ExportRatingsRequest.datasets:type_name -> gamehub.ratingext.Dataset
+	4, // 2: gamehub.ratingext.ExportRatingsRequest.from:type_name -> google.protobuf.Timestamp
+	4, // 3: gamehub.ratingext.ExportRatingsRequest.to:type_name -> google.protobuf.Timestamp
+	1, // 4: gamehub.ratingext.ExportChunk.dataset:type_name -> gamehub.ratingext.Dataset
+	2, // 5: gamehub.ratingext.RatingExportService.ExportRatings:input_type -> gamehub.ratingext.ExportRatingsRequest
+	3, // 6: gamehub.ratingext.RatingExportService.ExportRatings:output_type -> gamehub.ratingext.ExportChunk
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_ratingext_rating_export_proto_init() }
+func file_ratingext_rating_export_proto_init() {
+	if File_ratingext_rating_export_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ratingext_rating_export_proto_rawDesc), len(file_ratingext_rating_export_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ratingext_rating_export_proto_goTypes,
+		DependencyIndexes: file_ratingext_rating_export_proto_depIdxs,
+		EnumInfos:         file_ratingext_rating_export_proto_enumTypes,
+		MessageInfos:      file_ratingext_rating_export_proto_msgTypes,
+	}.Build()
+	File_ratingext_rating_export_proto = out.File
+	file_ratingext_rating_export_proto_goTypes = nil
+	file_ratingext_rating_export_proto_depIdxs = nil
+}
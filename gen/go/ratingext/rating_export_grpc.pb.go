@@ -0,0 +1,128 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ratingext/rating_export.proto
+
+package ratingextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RatingExportService_ExportRatings_FullMethodName = "/gamehub.ratingext.RatingExportService/ExportRatings"
+)
+
+// RatingExportServiceClient is the client API for RatingExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatingExportServiceClient interface {
+	// Выгрузка ratings и game_ratings из одного снимка БД (repeatable read).
+	// Каждый набор данных - отдельный файл в выбранном формате, нарезанный на чанки.
+	ExportRatings(ctx context.Context, in *ExportRatingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+}
+
+type ratingExportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingExportServiceClient(cc grpc.ClientConnInterface) RatingExportServiceClient {
+	return &ratingExportServiceClient{cc}
+}
+
+func (c *ratingExportServiceClient) ExportRatings(ctx context.Context, in *ExportRatingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RatingExportService_ServiceDesc.Streams[0], RatingExportService_ExportRatings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRatingsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RatingExportService_ExportRatingsClient = grpc.ServerStreamingClient[ExportChunk]
+
+// RatingExportServiceServer is the server API for RatingExportService service.
+// All implementations must embed UnimplementedRatingExportServiceServer
+// for forward compatibility.
+type RatingExportServiceServer interface {
+	// Выгрузка ratings и game_ratings из одного снимка БД (repeatable read).
+	// Каждый набор данных - отдельный файл в выбранном формате, нарезанный на чанки.
+	ExportRatings(*ExportRatingsRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	mustEmbedUnimplementedRatingExportServiceServer()
+}
+
+// UnimplementedRatingExportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatingExportServiceServer struct{}
+
+func (UnimplementedRatingExportServiceServer) ExportRatings(*ExportRatingsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportRatings not implemented")
+}
+func (UnimplementedRatingExportServiceServer) mustEmbedUnimplementedRatingExportServiceServer() {}
+func (UnimplementedRatingExportServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeRatingExportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingExportServiceServer will
+// result in compilation errors.
+type UnsafeRatingExportServiceServer interface {
+	mustEmbedUnimplementedRatingExportServiceServer()
+}
+
+func RegisterRatingExportServiceServer(s grpc.ServiceRegistrar, srv RatingExportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRatingExportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatingExportService_ServiceDesc, srv)
+}
+
+func _RatingExportService_ExportRatings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRatingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RatingExportServiceServer).ExportRatings(m, &grpc.GenericServerStream[ExportRatingsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RatingExportService_ExportRatingsServer = grpc.ServerStreamingServer[ExportChunk]
+
+// RatingExportService_ServiceDesc is the grpc.ServiceDesc for RatingExportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatingExportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gamehub.ratingext.RatingExportService",
+	HandlerType: (*RatingExportServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportRatings",
+			Handler:       _RatingExportService_ExportRatings_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ratingext/rating_export.proto",
+}
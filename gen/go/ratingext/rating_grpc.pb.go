@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ratingext/rating.proto
+
+package ratingextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RatingService_SubmitRating_FullMethodName  = "/gamehub.ratingext.RatingService/SubmitRating"
+	RatingService_GetGameRating_FullMethodName = "/gamehub.ratingext.RatingService/GetGameRating"
+	RatingService_GetTopGames_FullMethodName   = "/gamehub.ratingext.RatingService/GetTopGames"
+)
+
+// RatingServiceClient is the client API for RatingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Расширенная версия gamehub.RatingService: шкала, порядок и фильтр топа передаются в запросе,
+// нормализованный, взвешенный и проверенный рейтинги и признак заморозки - в ответе. gamehub.RatingService работает только со шкалой 1-10
+// и обычным средним.
+type RatingServiceClient interface {
+	SubmitRating(ctx context.Context, in *SubmitRatingRequest, opts ...grpc.CallOption) (*SubmitRatingResponse, error)
+	GetGameRating(ctx context.Context, in *GetGameRatingRequest, opts ...grpc.CallOption) (*GameRating, error)
+	// Страница топа по order; при непустом filter - только игры каталога с подходящими
+	// жанром, всеми тегами, годом выхода и издателем.
+	GetTopGames(ctx context.Context, in *GetTopGamesRequest, opts ...grpc.CallOption) (*GetTopGamesResponse, error)
+}
+
+type ratingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingServiceClient(cc grpc.ClientConnInterface) RatingServiceClient {
+	return &ratingServiceClient{cc}
+}
+
+func (c *ratingServiceClient) SubmitRating(ctx context.Context, in *SubmitRatingRequest, opts ...grpc.CallOption) (*SubmitRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitRatingResponse)
+	err := c.cc.Invoke(ctx, RatingService_SubmitRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingServiceClient) GetGameRating(ctx context.Context, in *GetGameRatingRequest, opts ...grpc.CallOption) (*GameRating, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameRating)
+	err := c.cc.Invoke(ctx, RatingService_GetGameRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingServiceClient) GetTopGames(ctx context.Context, in *GetTopGamesRequest, opts ...grpc.CallOption) (*GetTopGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopGamesResponse)
+	err := c.cc.Invoke(ctx, RatingService_GetTopGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatingServiceServer is the server API for RatingService service.
+// All implementations must embed UnimplementedRatingServiceServer
+// for forward compatibility.
+//
+// Расширенная версия gamehub.RatingService: шкала, порядок и фильтр топа передаются в запросе,
+// нормализованный, взвешенный и проверенный рейтинги и признак заморозки - в ответе. gamehub.RatingService работает только со шкалой 1-10
+// и обычным средним.
+type RatingServiceServer interface {
+	SubmitRating(context.Context, *SubmitRatingRequest) (*SubmitRatingResponse, error)
+	GetGameRating(context.Context, *GetGameRatingRequest) (*GameRating, error)
+	// Страница топа по order; при непустом filter - только игры каталога с подходящими
+	// жанром, всеми тегами, годом выхода и издателем.
+	GetTopGames(context.Context, *GetTopGamesRequest) (*GetTopGamesResponse, error)
+	mustEmbedUnimplementedRatingServiceServer()
+}
+
+// UnimplementedRatingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatingServiceServer struct{}
+
+func (UnimplementedRatingServiceServer) SubmitRating(context.Context, *SubmitRatingRequest) (*SubmitRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRating not implemented")
+}
+func (UnimplementedRatingServiceServer) GetGameRating(context.Context, *GetGameRatingRequest) (*GameRating, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameRating not implemented")
+}
+func (UnimplementedRatingServiceServer) GetTopGames(context.Context, *GetTopGamesRequest) (*GetTopGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopGames not implemented")
+}
+func (UnimplementedRatingServiceServer) mustEmbedUnimplementedRatingServiceServer() {}
+func (UnimplementedRatingServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeRatingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingServiceServer will
+// result in compilation errors.
+type UnsafeRatingServiceServer interface {
+	mustEmbedUnimplementedRatingServiceServer()
+}
+
+func RegisterRatingServiceServer(s grpc.ServiceRegistrar, srv RatingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRatingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatingService_ServiceDesc, srv)
+}
+
+func _RatingService_SubmitRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingServiceServer).SubmitRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingService_SubmitRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingServiceServer).SubmitRating(ctx, req.(*SubmitRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingService_GetGameRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingServiceServer).GetGameRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingService_GetGameRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingServiceServer).GetGameRating(ctx, req.(*GetGameRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingService_GetTopGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingServiceServer).GetTopGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingService_GetTopGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingServiceServer).GetTopGames(ctx, req.(*GetTopGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatingService_ServiceDesc is the grpc.ServiceDesc for RatingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gamehub.ratingext.RatingService",
+	HandlerType: (*RatingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitRating",
+			Handler:    _RatingService_SubmitRating_Handler,
+		},
+		{
+			MethodName: "GetGameRating",
+			Handler:    _RatingService_GetGameRating_Handler,
+		},
+		{
+			MethodName: "GetTopGames",
+			Handler:    _RatingService_GetTopGames_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ratingext/rating.proto",
+}
@@ -0,0 +1,337 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ratingext/rating_recommend.proto
+
+package ratingextv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecommendationSource int32
+
+const (
+	RecommendationSource_RECOMMENDATION_SOURCE_UNSPECIFIED RecommendationSource = 0
+	RecommendationSource_RECOMMENDATION_SOURCE_ITEM_CF     RecommendationSource = 1
+	RecommendationSource_RECOMMENDATION_SOURCE_POPULARITY  RecommendationSource = 2
+)
+
+// Enum value maps for RecommendationSource.
+var (
+	RecommendationSource_name = map[int32]string{
+		0: "RECOMMENDATION_SOURCE_UNSPECIFIED",
+		1: "RECOMMENDATION_SOURCE_ITEM_CF",
+		2: "RECOMMENDATION_SOURCE_POPULARITY",
+	}
+	RecommendationSource_value = map[string]int32{
+		"RECOMMENDATION_SOURCE_UNSPECIFIED": 0,
+		"RECOMMENDATION_SOURCE_ITEM_CF":     1,
+		"RECOMMENDATION_SOURCE_POPULARITY":  2,
+	}
+)
+
+func (x RecommendationSource) Enum() *RecommendationSource {
+	p := new(RecommendationSource)
+	*p = x
+	return p
+}
+
+func (x RecommendationSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecommendationSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_ratingext_rating_recommend_proto_enumTypes[0].Descriptor()
+}
+
+func (RecommendationSource) Type() protoreflect.EnumType {
+	return &file_ratingext_rating_recommend_proto_enumTypes[0]
+}
+
+func (x RecommendationSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecommendationSource.Descriptor instead.
+func (RecommendationSource) EnumDescriptor() ([]byte, []int) {
+	return file_ratingext_rating_recommend_proto_rawDescGZIP(), []int{0}
+}
+
+type RecommendGamesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 0 - 10; не больше recommend.max_limit из конфига
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// не предлагать игры, которые пользователь уже оценил
+	ExcludeRated  bool `protobuf:"varint,3,opt,name=exclude_rated,json=excludeRated,proto3" json:"exclude_rated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendGamesRequest) Reset() {
+	*x = RecommendGamesRequest{}
+	mi := &file_ratingext_rating_recommend_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendGamesRequest) ProtoMessage() {}
+
+func (x *RecommendGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_recommend_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendGamesRequest.ProtoReflect.Descriptor instead.
+func (*RecommendGamesRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_recommend_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecommendGamesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecommendGamesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RecommendGamesRequest) GetExcludeRated() bool {
+	if x != nil {
+		return x.ExcludeRated
+	}
+	return false
+}
+
+type Recommendation struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// предсказанная оценка на канонической шкале 1-10
+	PredictedScore float64              `protobuf:"fixed64,2,opt,name=predicted_score,json=predictedScore,proto3" json:"predicted_score,omitempty"`
+	Source         RecommendationSource `protobuf:"varint,3,opt,name=source,proto3,enum=gamehub.ratingext.RecommendationSource" json:"source,omitempty"`
+	// ITEM_CF - сколько оценённых пользователем игр похожи на эту, POPULARITY - число оценок игры
+	Support       int64 `protobuf:"varint,4,opt,name=support,proto3" json:"support,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	mi := &file_ratingext_rating_recommend_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_recommend_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_recommend_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Recommendation) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Recommendation) GetPredictedScore() float64 {
+	if x != nil {
+		return x.PredictedScore
+	}
+	return 0
+}
+
+func (x *Recommendation) GetSource() RecommendationSource {
+	if x != nil {
+		return x.Source
+	}
+	return RecommendationSource_RECOMMENDATION_SOURCE_UNSPECIFIED
+}
+
+func (x *Recommendation) GetSupport() int64 {
+	if x != nil {
+		return x.Support
+	}
+	return 0
+}
+
+type RecommendGamesResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// по убыванию predicted_score внутри каждого source; ITEM_CF идут первыми
+	Games         []*Recommendation `protobuf:"bytes,2,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendGamesResponse) Reset() {
+	*x = RecommendGamesResponse{}
+	mi := &file_ratingext_rating_recommend_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendGamesResponse) ProtoMessage() {}
+
+func (x *RecommendGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_recommend_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendGamesResponse.ProtoReflect.Descriptor instead.
+func (*RecommendGamesResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_recommend_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecommendGamesResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecommendGamesResponse) GetGames() []*Recommendation {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+var File_ratingext_rating_recommend_proto protoreflect.FileDescriptor
+
+const file_ratingext_rating_recommend_proto_rawDesc = "" +
+	"\n" +
+	" ratingext/rating_recommend.proto\x12\x11gamehub.ratingext\"k\n" +
+	"\x15RecommendGamesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12#\n" +
+	"\rexclude_rated\x18\x03 \x01(\bR\fexcludeRated\"\xad\x01\n" +
+	"\x0eRecommendation\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12'\n" +
+	"\x0fpredicted_score\x18\x02 \x01(\x01R\x0epredictedScore\x12?\n" +
+	"\x06source\x18\x03 \x01(\x0e2'.gamehub.ratingext.RecommendationSourceR\x06source\x12\x18\n" +
+	"\asupport\x18\x04 \x01(\x03R\asupport\"j\n" +
+	"\x16RecommendGamesResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\x05games\x18\x02 \x03(\v2!.gamehub.ratingext.RecommendationR\x05games*\x86\x01\n" +
+	"\x14RecommendationSource\x12%\n" +
+	"!RECOMMENDATION_SOURCE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dRECOMMENDATION_SOURCE_ITEM_CF\x10\x01\x12$\n" +
+	" RECOMMENDATION_SOURCE_POPULARITY\x10\x022\x7f\n" +
+	"\x16RatingRecommendService\x12e\n" +
+	"\x0eRecommendGames\x12(.gamehub.ratingext.RecommendGamesRequest\x1a).gamehub.ratingext.RecommendGamesResponseBFZDgithub.com/RozmiDan/gameReviewHubRating/gen/go/ratingext;ratingextv1b\x06proto3"
+
+var (
+	file_ratingext_rating_recommend_proto_rawDescOnce sync.Once
+	file_ratingext_rating_recommend_proto_rawDescData []byte
+)
+
+func file_ratingext_rating_recommend_proto_rawDescGZIP() []byte {
+	file_ratingext_rating_recommend_proto_rawDescOnce.Do(func() {
+		file_ratingext_rating_recommend_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ratingext_rating_recommend_proto_rawDesc), len(file_ratingext_rating_recommend_proto_rawDesc)))
+	})
+	return file_ratingext_rating_recommend_proto_rawDescData
+}
+
+var file_ratingext_rating_recommend_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ratingext_rating_recommend_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ratingext_rating_recommend_proto_goTypes = []any{
+	(RecommendationSource)(0),      // 0: gamehub.ratingext.RecommendationSource
+	(*RecommendGamesRequest)(nil),  // 1: gamehub.ratingext.RecommendGamesRequest
+	(*Recommendation)(nil),         // 2: gamehub.ratingext.Recommendation
+	(*RecommendGamesResponse)(nil), // 3: gamehub.ratingext.RecommendGamesResponse
+}
+var file_ratingext_rating_recommend_proto_depIdxs = []int32{
+	0, // 0: gamehub.ratingext.Recommendation.source:type_name -> gamehub.ratingext.RecommendationSource
+	2, // 1: gamehub.ratingext.RecommendGamesResponse.games:type_name -> gamehub.ratingext.Recommendation
+	1, // 2: gamehub.ratingext.RatingRecommendService.RecommendGames:input_type -> gamehub.ratingext.RecommendGamesRequest
+	3, // 3: gamehub.ratingext.RatingRecommendService.RecommendGames:output_type -> gamehub.ratingext.RecommendGamesResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ratingext_rating_recommend_proto_init() }
+func file_ratingext_rating_recommend_proto_init() {
+	if File_ratingext_rating_recommend_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ratingext_rating_recommend_proto_rawDesc), len(file_ratingext_rating_recommend_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ratingext_rating_recommend_proto_goTypes,
+		DependencyIndexes: file_ratingext_rating_recommend_proto_depIdxs,
+		EnumInfos:         file_ratingext_rating_recommend_proto_enumTypes,
+		MessageInfos:      file_ratingext_rating_recommend_proto_msgTypes,
+	}.Build()
+	File_ratingext_rating_recommend_proto = out.File
+	file_ratingext_rating_recommend_proto_goTypes = nil
+	file_ratingext_rating_recommend_proto_depIdxs = nil
+}
@@ -0,0 +1,126 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ratingext/rating_recommend.proto
+
+package ratingextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RatingRecommendService_RecommendGames_FullMethodName = "/gamehub.ratingext.RatingRecommendService/RecommendGames"
+)
+
+// RatingRecommendServiceClient is the client API for RatingRecommendService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatingRecommendServiceClient interface {
+	// Игры, которые пользователю должны понравиться: item-based CF по похожим играм
+	// (RatingSimilarityService), для новых пользователей и на добор - популярные игры.
+	RecommendGames(ctx context.Context, in *RecommendGamesRequest, opts ...grpc.CallOption) (*RecommendGamesResponse, error)
+}
+
+type ratingRecommendServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingRecommendServiceClient(cc grpc.ClientConnInterface) RatingRecommendServiceClient {
+	return &ratingRecommendServiceClient{cc}
+}
+
+func (c *ratingRecommendServiceClient) RecommendGames(ctx context.Context, in *RecommendGamesRequest, opts ...grpc.CallOption) (*RecommendGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendGamesResponse)
+	err := c.cc.Invoke(ctx, RatingRecommendService_RecommendGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatingRecommendServiceServer is the server API for RatingRecommendService service.
+// All implementations must embed UnimplementedRatingRecommendServiceServer
+// for forward compatibility.
+type RatingRecommendServiceServer interface {
+	// Игры, которые пользователю должны понравиться: item-based CF по похожим играм
+	// (RatingSimilarityService), для новых пользователей и на добор - популярные игры.
+	RecommendGames(context.Context, *RecommendGamesRequest) (*RecommendGamesResponse, error)
+	mustEmbedUnimplementedRatingRecommendServiceServer()
+}
+
+// UnimplementedRatingRecommendServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatingRecommendServiceServer struct{}
+
+func (UnimplementedRatingRecommendServiceServer) RecommendGames(context.Context, *RecommendGamesRequest) (*RecommendGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendGames not implemented")
+}
+func (UnimplementedRatingRecommendServiceServer) mustEmbedUnimplementedRatingRecommendServiceServer() {
+}
+func (UnimplementedRatingRecommendServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRatingRecommendServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingRecommendServiceServer will
+// result in compilation errors.
+type UnsafeRatingRecommendServiceServer interface {
+	mustEmbedUnimplementedRatingRecommendServiceServer()
+}
+
+func RegisterRatingRecommendServiceServer(s grpc.ServiceRegistrar, srv RatingRecommendServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRatingRecommendServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatingRecommendService_ServiceDesc, srv)
+}
+
+func _RatingRecommendService_RecommendGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingRecommendServiceServer).RecommendGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingRecommendService_RecommendGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingRecommendServiceServer).RecommendGames(ctx, req.(*RecommendGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatingRecommendService_ServiceDesc is the grpc.ServiceDesc for RatingRecommendService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatingRecommendService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gamehub.ratingext.RatingRecommendService",
+	HandlerType: (*RatingRecommendServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecommendGames",
+			Handler:    _RatingRecommendService_RecommendGames_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ratingext/rating_recommend.proto",
+}
@@ -0,0 +1,276 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ratingext/rating_similarity.proto
+
+package ratingextv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetSimilarGamesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// 0 - 10; не больше similarity.top_k из конфига
+	K             int32 `protobuf:"varint,2,opt,name=k,proto3" json:"k,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimilarGamesRequest) Reset() {
+	*x = GetSimilarGamesRequest{}
+	mi := &file_ratingext_rating_similarity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarGamesRequest) ProtoMessage() {}
+
+func (x *GetSimilarGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_similarity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarGamesRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilarGamesRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_similarity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetSimilarGamesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetSimilarGamesRequest) GetK() int32 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+type SimilarGame struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// (0, 1], чем больше, тем похожее
+	Similarity float64 `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	// сколько пользователей оценили обе игры
+	CoRaters      int64                  `protobuf:"varint,3,opt,name=co_raters,json=coRaters,proto3" json:"co_raters,omitempty"`
+	ComputedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarGame) Reset() {
+	*x = SimilarGame{}
+	mi := &file_ratingext_rating_similarity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarGame) ProtoMessage() {}
+
+func (x *SimilarGame) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_similarity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarGame.ProtoReflect.Descriptor instead.
+func (*SimilarGame) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_similarity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SimilarGame) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SimilarGame) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *SimilarGame) GetCoRaters() int64 {
+	if x != nil {
+		return x.CoRaters
+	}
+	return 0
+}
+
+func (x *SimilarGame) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type GetSimilarGamesResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// самые похожие первыми; пусто, если соседей с достаточным числом общих оценщиков нет
+	Games         []*SimilarGame `protobuf:"bytes,2,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimilarGamesResponse) Reset() {
+	*x = GetSimilarGamesResponse{}
+	mi := &file_ratingext_rating_similarity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarGamesResponse) ProtoMessage() {}
+
+func (x *GetSimilarGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_similarity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarGamesResponse.ProtoReflect.Descriptor instead.
+func (*GetSimilarGamesResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_similarity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSimilarGamesResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetSimilarGamesResponse) GetGames() []*SimilarGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+var File_ratingext_rating_similarity_proto protoreflect.FileDescriptor
+
+const file_ratingext_rating_similarity_proto_rawDesc = "" +
+	"\n" +
+	"!ratingext/rating_similarity.proto\x12\x11gamehub.ratingext\x1a\x1fgoogle/protobuf/timestamp.proto\"?\n" +
+	"\x16GetSimilarGamesRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\f\n" +
+	"\x01k\x18\x02 \x01(\x05R\x01k\"\xa0\x01\n" +
+	"\vSimilarGame\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x01R\n" +
+	"similarity\x12\x1b\n" +
+	"\tco_raters\x18\x03 \x01(\x03R\bcoRaters\x12;\n" +
+	"\vcomputed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"h\n" +
+	"\x17GetSimilarGamesResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x124\n" +
+	"\x05games\x18\x02 \x03(\v2\x1e.gamehub.ratingext.SimilarGameR\x05games2\x83\x01\n" +
+	"\x17RatingSimilarityService\x12h\n" +
+	"\x0fGetSimilarGames\x12).gamehub.ratingext.GetSimilarGamesRequest\x1a*.gamehub.ratingext.GetSimilarGamesResponseBFZDgithub.com/RozmiDan/gameReviewHubRating/gen/go/ratingext;ratingextv1b\x06proto3"
+
+var (
+	file_ratingext_rating_similarity_proto_rawDescOnce sync.Once
+	file_ratingext_rating_similarity_proto_rawDescData []byte
+)
+
+func file_ratingext_rating_similarity_proto_rawDescGZIP() []byte {
+	file_ratingext_rating_similarity_proto_rawDescOnce.Do(func() {
+		file_ratingext_rating_similarity_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ratingext_rating_similarity_proto_rawDesc), len(file_ratingext_rating_similarity_proto_rawDesc)))
+	})
+	return file_ratingext_rating_similarity_proto_rawDescData
+}
+
+var file_ratingext_rating_similarity_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ratingext_rating_similarity_proto_goTypes = []any{
+	(*GetSimilarGamesRequest)(nil),  // 0: gamehub.ratingext.GetSimilarGamesRequest
+	(*SimilarGame)(nil),             // 1: gamehub.ratingext.SimilarGame
+	(*GetSimilarGamesResponse)(nil), // 2: gamehub.ratingext.GetSimilarGamesResponse
+	(*timestamppb.Timestamp)(nil),   // 3: google.protobuf.Timestamp
+}
+var file_ratingext_rating_similarity_proto_depIdxs = []int32{
+	3, // 0: gamehub.ratingext.SimilarGame.computed_at:type_name -> google.protobuf.Timestamp
+	1, // 1: gamehub.ratingext.GetSimilarGamesResponse.games:type_name -> gamehub.ratingext.SimilarGame
+	0, // 2: gamehub.ratingext.RatingSimilarityService.GetSimilarGames:input_type -> gamehub.ratingext.GetSimilarGamesRequest
+	2, // 3: gamehub.ratingext.RatingSimilarityService.GetSimilarGames:output_type -> gamehub.ratingext.GetSimilarGamesResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ratingext_rating_similarity_proto_init() }
+func file_ratingext_rating_similarity_proto_init() {
+	if File_ratingext_rating_similarity_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ratingext_rating_similarity_proto_rawDesc), len(file_ratingext_rating_similarity_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ratingext_rating_similarity_proto_goTypes,
+		DependencyIndexes: file_ratingext_rating_similarity_proto_depIdxs,
+		MessageInfos:      file_ratingext_rating_similarity_proto_msgTypes,
+	}.Build()
+	File_ratingext_rating_similarity_proto = out.File
+	file_ratingext_rating_similarity_proto_goTypes = nil
+	file_ratingext_rating_similarity_proto_depIdxs = nil
+}
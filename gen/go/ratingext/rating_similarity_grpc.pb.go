@@ -0,0 +1,126 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ratingext/rating_similarity.proto
+
+package ratingextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RatingSimilarityService_GetSimilarGames_FullMethodName = "/gamehub.ratingext.RatingSimilarityService/GetSimilarGames"
+)
+
+// RatingSimilarityServiceClient is the client API for RatingSimilarityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatingSimilarityServiceClient interface {
+	// Похожие игры по оценкам пользователей (item-item, adjusted cosine или pearson
+	// по общим оценщикам). Соседи пересчитываются в фоне после изменения оценок.
+	GetSimilarGames(ctx context.Context, in *GetSimilarGamesRequest, opts ...grpc.CallOption) (*GetSimilarGamesResponse, error)
+}
+
+type ratingSimilarityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingSimilarityServiceClient(cc grpc.ClientConnInterface) RatingSimilarityServiceClient {
+	return &ratingSimilarityServiceClient{cc}
+}
+
+func (c *ratingSimilarityServiceClient) GetSimilarGames(ctx context.Context, in *GetSimilarGamesRequest, opts ...grpc.CallOption) (*GetSimilarGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSimilarGamesResponse)
+	err := c.cc.Invoke(ctx, RatingSimilarityService_GetSimilarGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatingSimilarityServiceServer is the server API for RatingSimilarityService service.
+// All implementations must embed UnimplementedRatingSimilarityServiceServer
+// for forward compatibility.
+type RatingSimilarityServiceServer interface {
+	// Похожие игры по оценкам пользователей (item-item, adjusted cosine или pearson
+	// по общим оценщикам). Соседи пересчитываются в фоне после изменения оценок.
+	GetSimilarGames(context.Context, *GetSimilarGamesRequest) (*GetSimilarGamesResponse, error)
+	mustEmbedUnimplementedRatingSimilarityServiceServer()
+}
+
+// UnimplementedRatingSimilarityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatingSimilarityServiceServer struct{}
+
+func (UnimplementedRatingSimilarityServiceServer) GetSimilarGames(context.Context, *GetSimilarGamesRequest) (*GetSimilarGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimilarGames not implemented")
+}
+func (UnimplementedRatingSimilarityServiceServer) mustEmbedUnimplementedRatingSimilarityServiceServer() {
+}
+func (UnimplementedRatingSimilarityServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRatingSimilarityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingSimilarityServiceServer will
+// result in compilation errors.
+type UnsafeRatingSimilarityServiceServer interface {
+	mustEmbedUnimplementedRatingSimilarityServiceServer()
+}
+
+func RegisterRatingSimilarityServiceServer(s grpc.ServiceRegistrar, srv RatingSimilarityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRatingSimilarityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatingSimilarityService_ServiceDesc, srv)
+}
+
+func _RatingSimilarityService_GetSimilarGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimilarGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingSimilarityServiceServer).GetSimilarGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingSimilarityService_GetSimilarGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingSimilarityServiceServer).GetSimilarGames(ctx, req.(*GetSimilarGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatingSimilarityService_ServiceDesc is the grpc.ServiceDesc for RatingSimilarityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatingSimilarityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gamehub.ratingext.RatingSimilarityService",
+	HandlerType: (*RatingSimilarityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSimilarGames",
+			Handler:    _RatingSimilarityService_GetSimilarGames_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ratingext/rating_similarity.proto",
+}
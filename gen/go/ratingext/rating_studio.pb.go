@@ -0,0 +1,599 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ratingext/rating_studio.proto
+
+package ratingextv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StudioRole int32
+
+const (
+	StudioRole_STUDIO_ROLE_UNSPECIFIED StudioRole = 0
+	StudioRole_STUDIO_ROLE_DEVELOPER   StudioRole = 1
+	StudioRole_STUDIO_ROLE_PUBLISHER   StudioRole = 2
+)
+
+// Enum value maps for StudioRole.
+var (
+	StudioRole_name = map[int32]string{
+		0: "STUDIO_ROLE_UNSPECIFIED",
+		1: "STUDIO_ROLE_DEVELOPER",
+		2: "STUDIO_ROLE_PUBLISHER",
+	}
+	StudioRole_value = map[string]int32{
+		"STUDIO_ROLE_UNSPECIFIED": 0,
+		"STUDIO_ROLE_DEVELOPER":   1,
+		"STUDIO_ROLE_PUBLISHER":   2,
+	}
+)
+
+func (x StudioRole) Enum() *StudioRole {
+	p := new(StudioRole)
+	*p = x
+	return p
+}
+
+func (x StudioRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StudioRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_ratingext_rating_studio_proto_enumTypes[0].Descriptor()
+}
+
+func (StudioRole) Type() protoreflect.EnumType {
+	return &file_ratingext_rating_studio_proto_enumTypes[0]
+}
+
+func (x StudioRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StudioRole.Descriptor instead.
+func (StudioRole) EnumDescriptor() ([]byte, []int) {
+	return file_ratingext_rating_studio_proto_rawDescGZIP(), []int{0}
+}
+
+type GetTopStudiosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Role  StudioRole             `protobuf:"varint,1,opt,name=role,proto3,enum=gamehub.ratingext.StudioRole" json:"role,omitempty"`
+	// 1 - studio.max_page_size из конфига
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// студии с меньшим числом оценок в топ не попадают
+	MinRatings int64 `protobuf:"varint,4,opt,name=min_ratings,json=minRatings,proto3" json:"min_ratings,omitempty"`
+	// шкала, на которую проецируется среднее; пусто - каноническая 1-10
+	Scale         string `protobuf:"bytes,5,opt,name=scale,proto3" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopStudiosRequest) Reset() {
+	*x = GetTopStudiosRequest{}
+	mi := &file_ratingext_rating_studio_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopStudiosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopStudiosRequest) ProtoMessage() {}
+
+func (x *GetTopStudiosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_studio_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopStudiosRequest.ProtoReflect.Descriptor instead.
+func (*GetTopStudiosRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_studio_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetTopStudiosRequest) GetRole() StudioRole {
+	if x != nil {
+		return x.Role
+	}
+	return StudioRole_STUDIO_ROLE_UNSPECIFIED
+}
+
+func (x *GetTopStudiosRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTopStudiosRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetTopStudiosRequest) GetMinRatings() int64 {
+	if x != nil {
+		return x.MinRatings
+	}
+	return 0
+}
+
+func (x *GetTopStudiosRequest) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+type StudioRating struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Role  StudioRole             `protobuf:"varint,1,opt,name=role,proto3,enum=gamehub.ratingext.StudioRole" json:"role,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// среднее по всем оценкам всех игр студии (взвешено числом оценок игр)
+	AverageRating float64 `protobuf:"fixed64,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	RatingsCount  int64   `protobuf:"varint,4,opt,name=ratings_count,json=ratingsCount,proto3" json:"ratings_count,omitempty"`
+	// сколько игр студии имеют оценки
+	GamesCount    int64 `protobuf:"varint,5,opt,name=games_count,json=gamesCount,proto3" json:"games_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudioRating) Reset() {
+	*x = StudioRating{}
+	mi := &file_ratingext_rating_studio_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudioRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudioRating) ProtoMessage() {}
+
+func (x *StudioRating) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_studio_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudioRating.ProtoReflect.Descriptor instead.
+func (*StudioRating) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_studio_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StudioRating) GetRole() StudioRole {
+	if x != nil {
+		return x.Role
+	}
+	return StudioRole_STUDIO_ROLE_UNSPECIFIED
+}
+
+func (x *StudioRating) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StudioRating) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *StudioRating) GetRatingsCount() int64 {
+	if x != nil {
+		return x.RatingsCount
+	}
+	return 0
+}
+
+func (x *StudioRating) GetGamesCount() int64 {
+	if x != nil {
+		return x.GamesCount
+	}
+	return 0
+}
+
+type GetTopStudiosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scale         string                 `protobuf:"bytes,1,opt,name=scale,proto3" json:"scale,omitempty"`
+	Studios       []*StudioRating        `protobuf:"bytes,2,rep,name=studios,proto3" json:"studios,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopStudiosResponse) Reset() {
+	*x = GetTopStudiosResponse{}
+	mi := &file_ratingext_rating_studio_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopStudiosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopStudiosResponse) ProtoMessage() {}
+
+func (x *GetTopStudiosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_studio_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopStudiosResponse.ProtoReflect.Descriptor instead.
+func (*GetTopStudiosResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_studio_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTopStudiosResponse) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+func (x *GetTopStudiosResponse) GetStudios() []*StudioRating {
+	if x != nil {
+		return x.Studios
+	}
+	return nil
+}
+
+type ListStudioGamesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Role  StudioRole             `protobuf:"varint,1,opt,name=role,proto3,enum=gamehub.ratingext.StudioRole" json:"role,omitempty"`
+	// без учёта регистра
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Scale         string `protobuf:"bytes,5,opt,name=scale,proto3" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStudioGamesRequest) Reset() {
+	*x = ListStudioGamesRequest{}
+	mi := &file_ratingext_rating_studio_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStudioGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudioGamesRequest) ProtoMessage() {}
+
+func (x *ListStudioGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_studio_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudioGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListStudioGamesRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_studio_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListStudioGamesRequest) GetRole() StudioRole {
+	if x != nil {
+		return x.Role
+	}
+	return StudioRole_STUDIO_ROLE_UNSPECIFIED
+}
+
+func (x *ListStudioGamesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListStudioGamesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListStudioGamesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListStudioGamesRequest) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+type StudioGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,2,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	RatingsCount  int64                  `protobuf:"varint,3,opt,name=ratings_count,json=ratingsCount,proto3" json:"ratings_count,omitempty"`
+	// игра заморожена, значения - снимок на момент заморозки
+	Frozen        bool `protobuf:"varint,4,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudioGame) Reset() {
+	*x = StudioGame{}
+	mi := &file_ratingext_rating_studio_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudioGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudioGame) ProtoMessage() {}
+
+func (x *StudioGame) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_studio_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudioGame.ProtoReflect.Descriptor instead.
+func (*StudioGame) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_studio_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StudioGame) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *StudioGame) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *StudioGame) GetRatingsCount() int64 {
+	if x != nil {
+		return x.RatingsCount
+	}
+	return 0
+}
+
+func (x *StudioGame) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+type ListStudioGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Studio        *StudioRating          `protobuf:"bytes,1,opt,name=studio,proto3" json:"studio,omitempty"`
+	Scale         string                 `protobuf:"bytes,2,opt,name=scale,proto3" json:"scale,omitempty"`
+	Games         []*StudioGame          `protobuf:"bytes,3,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStudioGamesResponse) Reset() {
+	*x = ListStudioGamesResponse{}
+	mi := &file_ratingext_rating_studio_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStudioGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudioGamesResponse) ProtoMessage() {}
+
+func (x *ListStudioGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_studio_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudioGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListStudioGamesResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_studio_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListStudioGamesResponse) GetStudio() *StudioRating {
+	if x != nil {
+		return x.Studio
+	}
+	return nil
+}
+
+func (x *ListStudioGamesResponse) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+func (x *ListStudioGamesResponse) GetGames() []*StudioGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+var File_ratingext_rating_studio_proto protoreflect.FileDescriptor
+
+const file_ratingext_rating_studio_proto_rawDesc = "" +
+	"\n" +
+	"\x1dratingext/rating_studio.proto\x12\x11gamehub.ratingext\"\xae\x01\n" +
+	"\x14GetTopStudiosRequest\x121\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x1d.gamehub.ratingext.StudioRoleR\x04role\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x1f\n" +
+	"\vmin_ratings\x18\x04 \x01(\x03R\n" +
+	"minRatings\x12\x14\n" +
+	"\x05scale\x18\x05 \x01(\tR\x05scale\"\xc2\x01\n" +
+	"\fStudioRating\x121\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x1d.gamehub.ratingext.StudioRoleR\x04role\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0eaverage_rating\x18\x03 \x01(\x01R\raverageRating\x12#\n" +
+	"\rratings_count\x18\x04 \x01(\x03R\fratingsCount\x12\x1f\n" +
+	"\vgames_count\x18\x05 \x01(\x03R\n" +
+	"gamesCount\"h\n" +
+	"\x15GetTopStudiosResponse\x12\x14\n" +
+	"\x05scale\x18\x01 \x01(\tR\x05scale\x129\n" +
+	"\astudios\x18\x02 \x03(\v2\x1f.gamehub.ratingext.StudioRatingR\astudios\"\xa3\x01\n" +
+	"\x16ListStudioGamesRequest\x121\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x1d.gamehub.ratingext.StudioRoleR\x04role\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05scale\x18\x05 \x01(\tR\x05scale\"\x89\x01\n" +
+	"\n" +
+	"StudioGame\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12%\n" +
+	"\x0eaverage_rating\x18\x02 \x01(\x01R\raverageRating\x12#\n" +
+	"\rratings_count\x18\x03 \x01(\x03R\fratingsCount\x12\x16\n" +
+	"\x06frozen\x18\x04 \x01(\bR\x06frozen\"\x9d\x01\n" +
+	"\x17ListStudioGamesResponse\x127\n" +
+	"\x06studio\x18\x01 \x01(\v2\x1f.gamehub.ratingext.StudioRatingR\x06studio\x12\x14\n" +
+	"\x05scale\x18\x02 \x01(\tR\x05scale\x123\n" +
+	"\x05games\x18\x03 \x03(\v2\x1d.gamehub.ratingext.StudioGameR\x05games*_\n" +
+	"\n" +
+	"StudioRole\x12\x1b\n" +
+	"\x17STUDIO_ROLE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15STUDIO_ROLE_DEVELOPER\x10\x01\x12\x19\n" +
+	"\x15STUDIO_ROLE_PUBLISHER\x10\x022\xe3\x01\n" +
+	"\x13RatingStudioService\x12b\n" +
+	"\rGetTopStudios\x12'.gamehub.ratingext.GetTopStudiosRequest\x1a(.gamehub.ratingext.GetTopStudiosResponse\x12h\n" +
+	"\x0fListStudioGames\x12).gamehub.ratingext.ListStudioGamesRequest\x1a*.gamehub.ratingext.ListStudioGamesResponseBFZDgithub.com/RozmiDan/gameReviewHubRating/gen/go/ratingext;ratingextv1b\x06proto3"
+
+var (
+	file_ratingext_rating_studio_proto_rawDescOnce sync.Once
+	file_ratingext_rating_studio_proto_rawDescData []byte
+)
+
+func file_ratingext_rating_studio_proto_rawDescGZIP() []byte {
+	file_ratingext_rating_studio_proto_rawDescOnce.Do(func() {
+		file_ratingext_rating_studio_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ratingext_rating_studio_proto_rawDesc), len(file_ratingext_rating_studio_proto_rawDesc)))
+	})
+	return file_ratingext_rating_studio_proto_rawDescData
+}
+
+var file_ratingext_rating_studio_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ratingext_rating_studio_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_ratingext_rating_studio_proto_goTypes = []any{
+	(StudioRole)(0),                 // 0: gamehub.ratingext.StudioRole
+	(*GetTopStudiosRequest)(nil),    // 1: gamehub.ratingext.GetTopStudiosRequest
+	(*StudioRating)(nil),            // 2: gamehub.ratingext.StudioRating
+	(*GetTopStudiosResponse)(nil),   // 3: gamehub.ratingext.GetTopStudiosResponse
+	(*ListStudioGamesRequest)(nil),  // 4: gamehub.ratingext.ListStudioGamesRequest
+	(*StudioGame)(nil),              // 5: gamehub.ratingext.StudioGame
+	(*ListStudioGamesResponse)(nil), // 6: gamehub.ratingext.ListStudioGamesResponse
+}
+var file_ratingext_rating_studio_proto_depIdxs = []int32{
+	0, // 0: gamehub.ratingext.GetTopStudiosRequest.role:type_name -> gamehub.ratingext.StudioRole
+	0, // 1: gamehub.ratingext.StudioRating.role:type_name -> gamehub.ratingext.StudioRole
+	2, // 2: gamehub.ratingext.GetTopStudiosResponse.studios:type_name -> gamehub.ratingext.StudioRating
+	0, // 3: gamehub.ratingext.ListStudioGamesRequest.role:type_name -> gamehub.ratingext.StudioRole
+	2, // 4: gamehub.ratingext.ListStudioGamesResponse.studio:type_name -> gamehub.ratingext.StudioRating
+	5, // 5: gamehub.ratingext.ListStudioGamesResponse.games:type_name -> gamehub.ratingext.StudioGame
+	1, // 6: gamehub.ratingext.RatingStudioService.GetTopStudios:input_type -> gamehub.ratingext.GetTopStudiosRequest
+	4, // 7: gamehub.ratingext.RatingStudioService.ListStudioGames:input_type -> gamehub.ratingext.ListStudioGamesRequest
+	3, // 8: gamehub.ratingext.RatingStudioService.GetTopStudios:output_type -> gamehub.ratingext.GetTopStudiosResponse
+	6, // 9: gamehub.ratingext.RatingStudioService.ListStudioGames:output_type -> gamehub.ratingext.ListStudioGamesResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_ratingext_rating_studio_proto_init() }
+func file_ratingext_rating_studio_proto_init() {
+	if File_ratingext_rating_studio_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ratingext_rating_studio_proto_rawDesc), len(file_ratingext_rating_studio_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ratingext_rating_studio_proto_goTypes,
+		DependencyIndexes: file_ratingext_rating_studio_proto_depIdxs,
+		EnumInfos:         file_ratingext_rating_studio_proto_enumTypes,
+		MessageInfos:      file_ratingext_rating_studio_proto_msgTypes,
+	}.Build()
+	File_ratingext_rating_studio_proto = out.File
+	file_ratingext_rating_studio_proto_goTypes = nil
+	file_ratingext_rating_studio_proto_depIdxs = nil
+}
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ratingext/rating_studio.proto
+
+package ratingextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RatingStudioService_GetTopStudios_FullMethodName   = "/gamehub.ratingext.RatingStudioService/GetTopStudios"
+	RatingStudioService_ListStudioGames_FullMethodName = "/gamehub.ratingext.RatingStudioService/ListStudioGames"
+)
+
+// RatingStudioServiceClient is the client API for RatingStudioService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatingStudioServiceClient interface {
+	// Разработчики или издатели по убыванию среднего по всем оценкам их игр. Связь игр
+	// со студиями берётся из проекции каталога; агрегаты обновляются вместе с оценками.
+	GetTopStudios(ctx context.Context, in *GetTopStudiosRequest, opts ...grpc.CallOption) (*GetTopStudiosResponse, error)
+	// Агрегат студии и её оценённые игры по убыванию среднего.
+	ListStudioGames(ctx context.Context, in *ListStudioGamesRequest, opts ...grpc.CallOption) (*ListStudioGamesResponse, error)
+}
+
+type ratingStudioServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingStudioServiceClient(cc grpc.ClientConnInterface) RatingStudioServiceClient {
+	return &ratingStudioServiceClient{cc}
+}
+
+func (c *ratingStudioServiceClient) GetTopStudios(ctx context.Context, in *GetTopStudiosRequest, opts ...grpc.CallOption) (*GetTopStudiosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopStudiosResponse)
+	err := c.cc.Invoke(ctx, RatingStudioService_GetTopStudios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingStudioServiceClient) ListStudioGames(ctx context.Context, in *ListStudioGamesRequest, opts ...grpc.CallOption) (*ListStudioGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStudioGamesResponse)
+	err := c.cc.Invoke(ctx, RatingStudioService_ListStudioGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatingStudioServiceServer is the server API for RatingStudioService service.
+// All implementations must embed UnimplementedRatingStudioServiceServer
+// for forward compatibility.
+type RatingStudioServiceServer interface {
+	// Разработчики или издатели по убыванию среднего по всем оценкам их игр. Связь игр
+	// со студиями берётся из проекции каталога; агрегаты обновляются вместе с оценками.
+	GetTopStudios(context.Context, *GetTopStudiosRequest) (*GetTopStudiosResponse, error)
+	// Агрегат студии и её оценённые игры по убыванию среднего.
+	ListStudioGames(context.Context, *ListStudioGamesRequest) (*ListStudioGamesResponse, error)
+	mustEmbedUnimplementedRatingStudioServiceServer()
+}
+
+// UnimplementedRatingStudioServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatingStudioServiceServer struct{}
+
+func (UnimplementedRatingStudioServiceServer) GetTopStudios(context.Context, *GetTopStudiosRequest) (*GetTopStudiosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopStudios not implemented")
+}
+func (UnimplementedRatingStudioServiceServer) ListStudioGames(context.Context, *ListStudioGamesRequest) (*ListStudioGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudioGames not implemented")
+}
+func (UnimplementedRatingStudioServiceServer) mustEmbedUnimplementedRatingStudioServiceServer() {}
+func (UnimplementedRatingStudioServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeRatingStudioServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingStudioServiceServer will
+// result in compilation errors.
+type UnsafeRatingStudioServiceServer interface {
+	mustEmbedUnimplementedRatingStudioServiceServer()
+}
+
+func RegisterRatingStudioServiceServer(s grpc.ServiceRegistrar, srv RatingStudioServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRatingStudioServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatingStudioService_ServiceDesc, srv)
+}
+
+func _RatingStudioService_GetTopStudios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopStudiosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingStudioServiceServer).GetTopStudios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingStudioService_GetTopStudios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingStudioServiceServer).GetTopStudios(ctx, req.(*GetTopStudiosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingStudioService_ListStudioGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudioGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingStudioServiceServer).ListStudioGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingStudioService_ListStudioGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingStudioServiceServer).ListStudioGames(ctx, req.(*ListStudioGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatingStudioService_ServiceDesc is the grpc.ServiceDesc for RatingStudioService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatingStudioService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gamehub.ratingext.RatingStudioService",
+	HandlerType: (*RatingStudioServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTopStudios",
+			Handler:    _RatingStudioService_GetTopStudios_Handler,
+		},
+		{
+			MethodName: "ListStudioGames",
+			Handler:    _RatingStudioService_ListStudioGames_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ratingext/rating_studio.proto",
+}
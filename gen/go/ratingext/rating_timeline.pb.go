@@ -0,0 +1,322 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ratingext/rating_timeline.proto
+
+package ratingextv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRatingTimelineRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	From   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// не задано - до текущего момента
+	To *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// "hour" или "day"; пусто - "day"
+	Granularity string `protobuf:"bytes,4,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	// шкала, на которую проецируется среднее; пусто - каноническая 1-10
+	Scale         string `protobuf:"bytes,5,opt,name=scale,proto3" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingTimelineRequest) Reset() {
+	*x = GetRatingTimelineRequest{}
+	mi := &file_ratingext_rating_timeline_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingTimelineRequest) ProtoMessage() {}
+
+func (x *GetRatingTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_timeline_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_timeline_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRatingTimelineRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetRatingTimelineRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetRatingTimelineRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetRatingTimelineRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetRatingTimelineRequest) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+type TimelinePoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// начало интервала (UTC); значения - на его конец
+	At            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,2,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	RatingsCount  int64                  `protobuf:"varint,3,opt,name=ratings_count,json=ratingsCount,proto3" json:"ratings_count,omitempty"`
+	// distribution[i] - число оценок со score, округлённым до i+1 на шкале 1-10
+	Distribution  []int64 `protobuf:"varint,4,rep,packed,name=distribution,proto3" json:"distribution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelinePoint) Reset() {
+	*x = TimelinePoint{}
+	mi := &file_ratingext_rating_timeline_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelinePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelinePoint) ProtoMessage() {}
+
+func (x *TimelinePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_timeline_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelinePoint.ProtoReflect.Descriptor instead.
+func (*TimelinePoint) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_timeline_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimelinePoint) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *TimelinePoint) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *TimelinePoint) GetRatingsCount() int64 {
+	if x != nil {
+		return x.RatingsCount
+	}
+	return 0
+}
+
+func (x *TimelinePoint) GetDistribution() []int64 {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+type GetRatingTimelineResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GameId      string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Granularity string                 `protobuf:"bytes,2,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Scale       string                 `protobuf:"bytes,3,opt,name=scale,proto3" json:"scale,omitempty"`
+	// интервалы до первого снимка игры пропускаются
+	Points        []*TimelinePoint `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingTimelineResponse) Reset() {
+	*x = GetRatingTimelineResponse{}
+	mi := &file_ratingext_rating_timeline_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingTimelineResponse) ProtoMessage() {}
+
+func (x *GetRatingTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_timeline_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetRatingTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_timeline_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRatingTimelineResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetRatingTimelineResponse) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetRatingTimelineResponse) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+func (x *GetRatingTimelineResponse) GetPoints() []*TimelinePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+var File_ratingext_rating_timeline_proto protoreflect.FileDescriptor
+
+const file_ratingext_rating_timeline_proto_rawDesc = "" +
+	"\n" +
+	"\x1fratingext/rating_timeline.proto\x12\x11gamehub.ratingext\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc7\x01\n" +
+	"\x18GetRatingTimelineRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12 \n" +
+	"\vgranularity\x18\x04 \x01(\tR\vgranularity\x12\x14\n" +
+	"\x05scale\x18\x05 \x01(\tR\x05scale\"\xab\x01\n" +
+	"\rTimelinePoint\x12*\n" +
+	"\x02at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12%\n" +
+	"\x0eaverage_rating\x18\x02 \x01(\x01R\raverageRating\x12#\n" +
+	"\rratings_count\x18\x03 \x01(\x03R\fratingsCount\x12\"\n" +
+	"\fdistribution\x18\x04 \x03(\x03R\fdistribution\"\xa6\x01\n" +
+	"\x19GetRatingTimelineResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12 \n" +
+	"\vgranularity\x18\x02 \x01(\tR\vgranularity\x12\x14\n" +
+	"\x05scale\x18\x03 \x01(\tR\x05scale\x128\n" +
+	"\x06points\x18\x04 \x03(\v2 .gamehub.ratingext.TimelinePointR\x06points2\x87\x01\n" +
+	"\x15RatingTimelineService\x12n\n" +
+	"\x11GetRatingTimeline\x12+.gamehub.ratingext.GetRatingTimelineRequest\x1a,.gamehub.ratingext.GetRatingTimelineResponseBFZDgithub.com/RozmiDan/gameReviewHubRating/gen/go/ratingext;ratingextv1b\x06proto3"
+
+var (
+	file_ratingext_rating_timeline_proto_rawDescOnce sync.Once
+	file_ratingext_rating_timeline_proto_rawDescData []byte
+)
+
+func file_ratingext_rating_timeline_proto_rawDescGZIP() []byte {
+	file_ratingext_rating_timeline_proto_rawDescOnce.Do(func() {
+		file_ratingext_rating_timeline_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ratingext_rating_timeline_proto_rawDesc), len(file_ratingext_rating_timeline_proto_rawDesc)))
+	})
+	return file_ratingext_rating_timeline_proto_rawDescData
+}
+
+var file_ratingext_rating_timeline_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ratingext_rating_timeline_proto_goTypes = []any{
+	(*GetRatingTimelineRequest)(nil),  // 0: gamehub.ratingext.GetRatingTimelineRequest
+	(*TimelinePoint)(nil),             // 1: gamehub.ratingext.TimelinePoint
+	(*GetRatingTimelineResponse)(nil), // 2: gamehub.ratingext.GetRatingTimelineResponse
+	(*timestamppb.Timestamp)(nil),     // 3: google.protobuf.Timestamp
+}
+var file_ratingext_rating_timeline_proto_depIdxs = []int32{
+	3, // 0: gamehub.ratingext.GetRatingTimelineRequest.from:type_name -> google.protobuf.Timestamp
+	3, // 1: gamehub.ratingext.GetRatingTimelineRequest.to:type_name -> google.protobuf.Timestamp
+	3, // 2: gamehub.ratingext.TimelinePoint.at:type_name -> google.protobuf.Timestamp
+	1, // 3: gamehub.ratingext.GetRatingTimelineResponse.points:type_name -> gamehub.ratingext.TimelinePoint
+	0, // 4: gamehub.ratingext.RatingTimelineService.GetRatingTimeline:input_type -> gamehub.ratingext.GetRatingTimelineRequest
+	2, // 5: gamehub.ratingext.RatingTimelineService.GetRatingTimeline:output_type -> gamehub.ratingext.GetRatingTimelineResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ratingext_rating_timeline_proto_init() }
+func file_ratingext_rating_timeline_proto_init() {
+	if File_ratingext_rating_timeline_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ratingext_rating_timeline_proto_rawDesc), len(file_ratingext_rating_timeline_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ratingext_rating_timeline_proto_goTypes,
+		DependencyIndexes: file_ratingext_rating_timeline_proto_depIdxs,
+		MessageInfos:      file_ratingext_rating_timeline_proto_msgTypes,
+	}.Build()
+	File_ratingext_rating_timeline_proto = out.File
+	file_ratingext_rating_timeline_proto_goTypes = nil
+	file_ratingext_rating_timeline_proto_depIdxs = nil
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ratingext/rating_timeline.proto
+
+package ratingextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RatingTimelineService_GetRatingTimeline_FullMethodName = "/gamehub.ratingext.RatingTimelineService/GetRatingTimeline"
+)
+
+// RatingTimelineServiceClient is the client API for RatingTimelineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatingTimelineServiceClient interface {
+	// Рейтинг игры по часам или дням на основе периодических снимков game_ratings.
+	GetRatingTimeline(ctx context.Context, in *GetRatingTimelineRequest, opts ...grpc.CallOption) (*GetRatingTimelineResponse, error)
+}
+
+type ratingTimelineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingTimelineServiceClient(cc grpc.ClientConnInterface) RatingTimelineServiceClient {
+	return &ratingTimelineServiceClient{cc}
+}
+
+func (c *ratingTimelineServiceClient) GetRatingTimeline(ctx context.Context, in *GetRatingTimelineRequest, opts ...grpc.CallOption) (*GetRatingTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRatingTimelineResponse)
+	err := c.cc.Invoke(ctx, RatingTimelineService_GetRatingTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatingTimelineServiceServer is the server API for RatingTimelineService service.
+// All implementations must embed UnimplementedRatingTimelineServiceServer
+// for forward compatibility.
+type RatingTimelineServiceServer interface {
+	// Рейтинг игры по часам или дням на основе периодических снимков game_ratings.
+	GetRatingTimeline(context.Context, *GetRatingTimelineRequest) (*GetRatingTimelineResponse, error)
+	mustEmbedUnimplementedRatingTimelineServiceServer()
+}
+
+// UnimplementedRatingTimelineServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatingTimelineServiceServer struct{}
+
+func (UnimplementedRatingTimelineServiceServer) GetRatingTimeline(context.Context, *GetRatingTimelineRequest) (*GetRatingTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatingTimeline not implemented")
+}
+func (UnimplementedRatingTimelineServiceServer) mustEmbedUnimplementedRatingTimelineServiceServer() {}
+func (UnimplementedRatingTimelineServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeRatingTimelineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingTimelineServiceServer will
+// result in compilation errors.
+type UnsafeRatingTimelineServiceServer interface {
+	mustEmbedUnimplementedRatingTimelineServiceServer()
+}
+
+func RegisterRatingTimelineServiceServer(s grpc.ServiceRegistrar, srv RatingTimelineServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRatingTimelineServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatingTimelineService_ServiceDesc, srv)
+}
+
+func _RatingTimelineService_GetRatingTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingTimelineServiceServer).GetRatingTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatingTimelineService_GetRatingTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingTimelineServiceServer).GetRatingTimeline(ctx, req.(*GetRatingTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatingTimelineService_ServiceDesc is the grpc.ServiceDesc for RatingTimelineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatingTimelineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gamehub.ratingext.RatingTimelineService",
+	HandlerType: (*RatingTimelineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRatingTimeline",
+			Handler:    _RatingTimelineService_GetRatingTimeline_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ratingext/rating_timeline.proto",
+}
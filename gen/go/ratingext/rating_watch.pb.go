@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ratingext/rating_watch.proto
+
+package ratingextv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WatchGameRatingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// пусто - изменения всех игр, без начального снимка
+	GameIds []string `protobuf:"bytes,1,rep,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"`
+	// шкала, на которую проецируется среднее; пусто - каноническая 1-10
+	Scale         string `protobuf:"bytes,2,opt,name=scale,proto3" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchGameRatingsRequest) Reset() {
+	*x = WatchGameRatingsRequest{}
+	mi := &file_ratingext_rating_watch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchGameRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGameRatingsRequest) ProtoMessage() {}
+
+func (x *WatchGameRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_watch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGameRatingsRequest.ProtoReflect.Descriptor instead.
+func (*WatchGameRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_watch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchGameRatingsRequest) GetGameIds() []string {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+func (x *WatchGameRatingsRequest) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+type GameRatingUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,2,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	// 0 - у игры не осталось оценок
+	RatingsCount int64  `protobuf:"varint,3,opt,name=ratings_count,json=ratingsCount,proto3" json:"ratings_count,omitempty"`
+	Scale        string `protobuf:"bytes,4,opt,name=scale,proto3" json:"scale,omitempty"`
+	// не задано для начального снимка
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameRatingUpdate) Reset() {
+	*x = GameRatingUpdate{}
+	mi := &file_ratingext_rating_watch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRatingUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRatingUpdate) ProtoMessage() {}
+
+func (x *GameRatingUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_ratingext_rating_watch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRatingUpdate.ProtoReflect.Descriptor instead.
+func (*GameRatingUpdate) Descriptor() ([]byte, []int) {
+	return file_ratingext_rating_watch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GameRatingUpdate) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameRatingUpdate) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *GameRatingUpdate) GetRatingsCount() int64 {
+	if x != nil {
+		return x.RatingsCount
+	}
+	return 0
+}
+
+func (x *GameRatingUpdate) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+func (x *GameRatingUpdate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_ratingext_rating_watch_proto protoreflect.FileDescriptor
+
+const file_ratingext_rating_watch_proto_rawDesc = "" +
+	"\n" +
+	"\x1cratingext/rating_watch.proto\x12\x11gamehub.ratingext\x1a\x1fgoogle/protobuf/timestamp.proto\"J\n" +
+	"\x17WatchGameRatingsRequest\x12\x19\n" +
+	"\bgame_ids\x18\x01 \x03(\tR\agameIds\x12\x14\n" +
+	"\x05scale\x18\x02 \x01(\tR\x05scale\"\xc8\x01\n" +
+	"\x10GameRatingUpdate\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12%\n" +
+	"\x0eaverage_rating\x18\x02 \x01(\x01R\raverageRating\x12#\n" +
+	"\rratings_count\x18\x03 \x01(\x03R\fratingsCount\x12\x14\n" +
+	"\x05scale\x18\x04 \x01(\tR\x05scale\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt2{\n" +
+	"\x12RatingWatchService\x12e\n" +
+	"\x10WatchGameRatings\x12*.gamehub.ratingext.WatchGameRatingsRequest\x1a#.gamehub.ratingext.GameRatingUpdate0\x01BFZDgithub.com/RozmiDan/gameReviewHubRating/gen/go/ratingext;ratingextv1b\x06proto3"
+
+var (
+	file_ratingext_rating_watch_proto_rawDescOnce sync.Once
+	file_ratingext_rating_watch_proto_rawDescData []byte
+)
+
+func file_ratingext_rating_watch_proto_rawDescGZIP() []byte {
+	file_ratingext_rating_watch_proto_rawDescOnce.Do(func() {
+		file_ratingext_rating_watch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ratingext_rating_watch_proto_rawDesc), len(file_ratingext_rating_watch_proto_rawDesc)))
+	})
+	return file_ratingext_rating_watch_proto_rawDescData
+}
+
+var file_ratingext_rating_watch_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ratingext_rating_watch_proto_goTypes = []any{
+	(*WatchGameRatingsRequest)(nil), // 0: gamehub.ratingext.WatchGameRatingsRequest
+	(*GameRatingUpdate)(nil),        // 1: gamehub.ratingext.GameRatingUpdate
+	(*timestamppb.Timestamp)(nil),   // 2: google.protobuf.Timestamp
+}
+var file_ratingext_rating_watch_proto_depIdxs = []int32{
+	2, // 0: gamehub.ratingext.GameRatingUpdate.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 1: gamehub.ratingext.RatingWatchService.WatchGameRatings:input_type -> gamehub.ratingext.WatchGameRatingsRequest
+	1, // 2: gamehub.ratingext.RatingWatchService.WatchGameRatings:output_type -> gamehub.ratingext.GameRatingUpdate
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_ratingext_rating_watch_proto_init() }
+func file_ratingext_rating_watch_proto_init() {
+	if File_ratingext_rating_watch_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ratingext_rating_watch_proto_rawDesc), len(file_ratingext_rating_watch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ratingext_rating_watch_proto_goTypes,
+		DependencyIndexes: file_ratingext_rating_watch_proto_depIdxs,
+		MessageInfos:      file_ratingext_rating_watch_proto_msgTypes,
+	}.Build()
+	File_ratingext_rating_watch_proto = out.File
+	file_ratingext_rating_watch_proto_goTypes = nil
+	file_ratingext_rating_watch_proto_depIdxs = nil
+}
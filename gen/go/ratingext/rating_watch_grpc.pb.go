@@ -0,0 +1,130 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ratingext/rating_watch.proto
+
+package ratingextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RatingWatchService_WatchGameRatings_FullMethodName = "/gamehub.ratingext.RatingWatchService/WatchGameRatings"
+)
+
+// RatingWatchServiceClient is the client API for RatingWatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatingWatchServiceClient interface {
+	// Сначала текущие рейтинги запрошенных игр, затем их изменения. Частые изменения
+	// одной игры схлопываются; клиент, который не успевает читать, отключается
+	// с RESOURCE_EXHAUSTED (reason SLOW_CONSUMER).
+	WatchGameRatings(ctx context.Context, in *WatchGameRatingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameRatingUpdate], error)
+}
+
+type ratingWatchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingWatchServiceClient(cc grpc.ClientConnInterface) RatingWatchServiceClient {
+	return &ratingWatchServiceClient{cc}
+}
+
+func (c *ratingWatchServiceClient) WatchGameRatings(ctx context.Context, in *WatchGameRatingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameRatingUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RatingWatchService_ServiceDesc.Streams[0], RatingWatchService_WatchGameRatings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchGameRatingsRequest, GameRatingUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RatingWatchService_WatchGameRatingsClient = grpc.ServerStreamingClient[GameRatingUpdate]
+
+// RatingWatchServiceServer is the server API for RatingWatchService service.
+// All implementations must embed UnimplementedRatingWatchServiceServer
+// for forward compatibility.
+type RatingWatchServiceServer interface {
+	// Сначала текущие рейтинги запрошенных игр, затем их изменения. Частые изменения
+	// одной игры схлопываются; клиент, который не успевает читать, отключается
+	// с RESOURCE_EXHAUSTED (reason SLOW_CONSUMER).
+	WatchGameRatings(*WatchGameRatingsRequest, grpc.ServerStreamingServer[GameRatingUpdate]) error
+	mustEmbedUnimplementedRatingWatchServiceServer()
+}
+
+// UnimplementedRatingWatchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatingWatchServiceServer struct{}
+
+func (UnimplementedRatingWatchServiceServer) WatchGameRatings(*WatchGameRatingsRequest, grpc.ServerStreamingServer[GameRatingUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGameRatings not implemented")
+}
+func (UnimplementedRatingWatchServiceServer) mustEmbedUnimplementedRatingWatchServiceServer() {}
+func (UnimplementedRatingWatchServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeRatingWatchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingWatchServiceServer will
+// result in compilation errors.
+type UnsafeRatingWatchServiceServer interface {
+	mustEmbedUnimplementedRatingWatchServiceServer()
+}
+
+func RegisterRatingWatchServiceServer(s grpc.ServiceRegistrar, srv RatingWatchServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRatingWatchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatingWatchService_ServiceDesc, srv)
+}
+
+func _RatingWatchService_WatchGameRatings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGameRatingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RatingWatchServiceServer).WatchGameRatings(m, &grpc.GenericServerStream[WatchGameRatingsRequest, GameRatingUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RatingWatchService_WatchGameRatingsServer = grpc.ServerStreamingServer[GameRatingUpdate]
+
+// RatingWatchService_ServiceDesc is the grpc.ServiceDesc for RatingWatchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatingWatchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gamehub.ratingext.RatingWatchService",
+	HandlerType: (*RatingWatchServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGameRatings",
+			Handler:       _RatingWatchService_WatchGameRatings_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ratingext/rating_watch.proto",
+}
@@ -2,22 +2,39 @@ package app
 
 import (
 	"context"
-	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/RozmiDan/gameReviewHubRating/db"
 	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/health"
+	"github.com/RozmiDan/gameReviewHubRating/internal/metrics"
+	"github.com/RozmiDan/gameReviewHubRating/internal/ratelimit"
 	postgres_storage "github.com/RozmiDan/gameReviewHubRating/internal/storage/postgres"
 	grpc_rating "github.com/RozmiDan/gameReviewHubRating/internal/transport/grpc"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/grpc/admin_server"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/grpc/compare_server"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/grpc/export_server"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/grpc/recommend_server"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/grpc/similarity_server"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/grpc/studio_server"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/grpc/timeline_server"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/grpc/watch_server"
 	http_serv "github.com/RozmiDan/gameReviewHubRating/internal/transport/http"
 	kafka_rating "github.com/RozmiDan/gameReviewHubRating/internal/transport/kafka"
 	"github.com/RozmiDan/gameReviewHubRating/internal/usecase"
+	"github.com/RozmiDan/gameReviewHubRating/internal/watch"
 
+	"github.com/RozmiDan/gameReviewHubRating/pkg/lifecycle"
 	"github.com/RozmiDan/gameReviewHubRating/pkg/logger"
 	"github.com/RozmiDan/gameReviewHubRating/pkg/postgres"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 func Run(cfg *config.Config) {
@@ -25,40 +42,222 @@ func Run(cfg *config.Config) {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
+	manager := lifecycle.New(logger)
+
+	// tracing
+	if cfg.Tracing.Enabled {
+		shutdownTracing, err := tracing.Setup(ctx, tracing.Options{
+			ServiceName:    cfg.AppInfo.Name,
+			ServiceVersion: cfg.AppInfo.Version,
+			Exporter:       cfg.Tracing.Exporter,
+			Endpoint:       cfg.Tracing.Endpoint,
+			Insecure:       cfg.Tracing.Insecure,
+			SampleRatio:    cfg.Tracing.SampleRatio,
+		})
+		if err != nil {
+			logger.Error("Cant setup tracing", zap.Error(err))
+			os.Exit(1)
+		}
+		manager.Add(lifecycle.Hook{HookName: "tracing", OnStop: shutdownTracing})
+	}
+
 	//migrations
 	db.SetupPostgres(cfg, logger)
 	logger.Info("Migrations completed successfully\n")
 
 	// storage
-	pg, err := postgres.New(cfg.PostgreURL.URL, postgres.MaxPoolSize(20))
+	pg, err := postgres.New(cfg.PostgreURL.URL,
+		postgres.MaxPoolSize(int(cfg.PostgreURL.PgPoolMax)),
+		postgres.MinPoolSize(int(cfg.PostgreURL.PgPoolMin)),
+		postgres.MaxConnLifetime(cfg.PostgreURL.MaxConnLifetime),
+		postgres.MaxConnIdleTime(cfg.PostgreURL.MaxConnIdleTime),
+		postgres.ConnAttempts(cfg.PostgreURL.ConnAttempts),
+		postgres.ConnTimeout(cfg.PostgreURL.ConnTimeout),
+		postgres.QueryTracer(postgres.NewOtelTracer()),
+	)
 	if err != nil {
 		logger.Error("Cant open database", zap.Error(err))
 		os.Exit(1)
 	}
-	defer pg.Close()
+	manager.Add(lifecycle.Hook{HookName: "postgres", OnStop: func(context.Context) error {
+		pg.Close()
+		return nil
+	}})
 
-	repo := postgres_storage.New(pg, logger)
+	// metrics
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	appMetrics := metrics.New(registry)
+
+	repo := postgres_storage.New(pg, appMetrics, logger)
 
 	// usecase
-	ratingUC := usecase.NewRatingService(repo, logger)
+	validator, err := usecase.NewValidator(cfg.Validation)
+	if err != nil {
+		logger.Error("Cant create validator", zap.Error(err))
+		os.Exit(1)
+	}
+	ratingUC, err := usecase.NewRatingService(repo, validator, cfg.Embargo, logger)
+	if err != nil {
+		logger.Error("Cant create rating service", zap.Error(err))
+		os.Exit(1)
+	}
+	exportUC := usecase.NewExportService(repo, validator, logger)
+	snapshotUC := usecase.NewSnapshotService(repo, validator, cfg.Snapshots, logger)
+	similarityUC, err := usecase.NewSimilarityService(repo, validator, cfg.Similarity, logger)
+	if err != nil {
+		logger.Error("Cant create similarity service", zap.Error(err))
+		os.Exit(1)
+	}
+	recommendUC, err := usecase.NewRecommendService(repo, validator, cfg.Similarity, cfg.Recommend, logger)
+	if err != nil {
+		logger.Error("Cant create recommend service", zap.Error(err))
+		os.Exit(1)
+	}
+	normalizeUC, err := usecase.NewNormalizeService(repo, cfg.Normalize, logger)
+	if err != nil {
+		logger.Error("Cant create normalize service", zap.Error(err))
+		os.Exit(1)
+	}
+	reputationUC := usecase.NewReputationService(repo, validator, cfg.Reputation, logger)
+	moderationUC := usecase.NewModerationService(repo, validator, cfg.Moderation, logger)
+	freezeUC := usecase.NewFreezeService(repo, validator, logger)
+	embargoUC := usecase.NewEmbargoService(repo, validator, logger)
+	ownershipUC := usecase.NewOwnershipService(repo, validator, cfg.Ownership, logger)
+	catalogUC := usecase.NewCatalogService(repo, validator, logger)
+	studioUC := usecase.NewStudioService(repo, validator, cfg.Studio, logger)
+	compareUC := usecase.NewCompareService(repo, validator, cfg.Compare, logger)
+
+	// rate limiting
+	var limiter *ratelimit.Limiter
+	if cfg.RateLimit.Enabled {
+		limiter, err = ratelimit.New(cfg.RateLimit, logger)
+		if err != nil {
+			logger.Error("Cant create rate limiter", zap.Error(err))
+			os.Exit(1)
+		}
+		manager.Add(lifecycle.Hook{HookName: "rate-limiter", OnStop: func(context.Context) error {
+			return limiter.Close()
+		}})
+	}
 
 	// Kafka consumer
-	consumer := kafka_rating.NewConsumer(cfg.Kafka, ratingUC, logger)
-	defer consumer.Close()
-	go consumer.Start(ctx)
+	consumer := kafka_rating.NewConsumer(cfg.Kafka, ratingUC, limiter, appMetrics, logger)
+	manager.Add(consumer)
+
+	// health
+	checker := health.New(2 * time.Second)
+	checker.Register("postgres", pg.Pool.Ping)
+	checker.Register("migrations", func(ctx context.Context) error {
+		return db.CheckVersion(ctx, pg.Pool)
+	})
+	checker.Register("kafka", consumer.Health)
+
+	// события аккаунтов для весов оценщиков
+	if cfg.Kafka.TopicUserEvents != "" {
+		userEvents := kafka_rating.NewEventConsumer(cfg.Kafka, "user-events", cfg.Kafka.TopicUserEvents,
+			reputationUC.HandleUserEvent, appMetrics, logger)
+		manager.Add(userEvents)
+		checker.Register("kafka-user-events", userEvents.Health)
+	}
+
+	// владение и наигранное время для рейтинга проверенных игроков
+	if cfg.Kafka.TopicOwnership != "" {
+		ownership := kafka_rating.NewEventConsumer(cfg.Kafka, "ownership", cfg.Kafka.TopicOwnership,
+			ownershipUC.HandleOwnershipEvent, appMetrics, logger)
+		manager.Add(ownership)
+		checker.Register("kafka-ownership", ownership.Health)
+	}
+
+	// метаданные каталога для фильтров топа
+	if cfg.Kafka.TopicCatalog != "" {
+		catalog := kafka_rating.NewEventConsumer(cfg.Kafka, "catalog", cfg.Kafka.TopicCatalog,
+			catalogUC.HandleCatalogEvent, appMetrics, logger)
+		manager.Add(catalog)
+		checker.Register("kafka-catalog", catalog.Health)
+	}
+
+	// live updates
+	var (
+		hub     *watch.Hub
+		watchUC http_serv.WatchUseCase
+	)
+	services := []grpc_rating.Registrar{
+		func(s *grpc.Server) { export_server.Register(s, exportUC) },
+		func(s *grpc.Server) { timeline_server.Register(s, snapshotUC) },
+		func(s *grpc.Server) { similarity_server.Register(s, similarityUC) },
+		func(s *grpc.Server) { recommend_server.Register(s, recommendUC) },
+		func(s *grpc.Server) { admin_server.Register(s, moderationUC, freezeUC, embargoUC) },
+		func(s *grpc.Server) { studio_server.Register(s, studioUC) },
+		func(s *grpc.Server) { compare_server.Register(s, compareUC) },
+	}
+	if cfg.Watch.Enabled {
+		hub = watch.New(repo, cfg.Watch, appMetrics, logger)
+		uc := usecase.NewWatchService(hub, repo, validator, cfg.Watch.MaxGames, logger)
+		watchUC = uc
+		services = append(services, func(s *grpc.Server) { watch_server.Register(s, uc) })
+	}
+
+	// снимки для GetRatingTimeline
+	if cfg.Snapshots.Enabled {
+		manager.Add(lifecycle.NewPeriodic("snapshots", cfg.Snapshots.Interval, snapshotUC.TakeSnapshots, logger))
+	}
+
+	// пересчёт похожих игр после изменения оценок
+	if cfg.Similarity.Enabled {
+		manager.Add(lifecycle.NewPeriodic("similarity", cfg.Similarity.RefreshInterval, similarityUC.Refresh, logger))
+	}
+
+	// нормализованный рейтинг игр
+	if cfg.Normalize.Enabled {
+		manager.Add(lifecycle.NewPeriodic("normalize", cfg.Normalize.Interval, normalizeUC.Recompute, logger))
+	}
+
+	// истёкшие заморозки рейтинга
+	manager.Add(lifecycle.NewPeriodic("freeze-expiry", cfg.Freeze.ExpiryInterval, freezeUC.ExpireFreezes, logger))
+
+	// отложенные оценки вышедших игр
+	manager.Add(lifecycle.NewPeriodic("embargo-release", cfg.Embargo.ReleaseInterval, embargoUC.ReleaseParked, logger))
+
+	// веса оценщиков и взвешенный рейтинг
+	if cfg.Reputation.Enabled {
+		manager.Add(lifecycle.NewPeriodic("reputation", cfg.Reputation.Interval, reputationUC.Recalculate, logger))
+	}
 
 	// metrics for prom
-	go func() {
-		mux := http_serv.New(logger)
-		logger.Info("starting metrics HTTP server on :8080")
-		if err := http.ListenAndServe(":8080", mux); err != nil {
-			logger.Fatal("metrics HTTP server crashed", zap.Error(err))
-		}
-	}()
+	mux := http_serv.New(logger, registry, checker, watchUC, cfg.Watch.HeartbeatInterval)
+	manager.Add(http_serv.NewServer(cfg.HTTP, mux, logger))
 
 	// grpc
-	if err := grpc_rating.StartServer(ctx, cfg.GRPC.Address, logger, ratingUC); err != nil {
-		logger.Fatal("gRPC server crashed", zap.Error(err))
+	manager.Add(grpc_rating.NewServer(cfg.GRPC.Address, logger, ratingUC, limiter, appMetrics, checker, services...))
+
+	// hub останавливается раньше транспортов: закрывает стримы, иначе GracefulStop и
+	// http.Server.Shutdown ждали бы их до таймаута
+	if hub != nil {
+		manager.Add(hub)
+	}
+
+	// останавливается первым: readiness уходит в NOT_SERVING до остановки транспортов
+	manager.Add(lifecycle.Hook{HookName: "health", OnStop: func(context.Context) error {
+		checker.SetShuttingDown()
+		return nil
+	}})
+
+	if err := manager.Start(ctx, cfg.AppInfo.ShutdownTimeout); err != nil {
+		logger.Fatal("service failed to start", zap.Error(err))
+	}
+
+	runErr := manager.Wait(ctx)
+
+	if err := manager.Stop(cfg.AppInfo.ShutdownTimeout); err != nil {
+		logger.Error("graceful shutdown failed", zap.Error(err))
+	}
+
+	if runErr != nil {
+		logger.Fatal("service crashed", zap.Error(runErr))
 	}
 
 	logger.Info("service stopped")
@@ -0,0 +1,160 @@
+// Package compare - статистика для сравнения игр по оценкам. Оценки приходят счётчиками
+// по значениям score (entity.ScoreCount), поэтому всё считается за один проход по
+// различным значениям, а не по каждой оценке.
+package compare
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// Summary - число оценок, среднее и выборочное стандартное отклонение.
+func Summary(scores []entity.ScoreCount) (n int64, mean, sd float64) {
+	var sum float64
+	for _, s := range scores {
+		n += s.Count
+		sum += s.Score * float64(s.Count)
+	}
+	if n == 0 {
+		return 0, 0, 0
+	}
+	mean = sum / float64(n)
+	if n < 2 {
+		return n, mean, 0
+	}
+
+	var ss float64
+	for _, s := range scores {
+		d := s.Score - mean
+		ss += d * d * float64(s.Count)
+	}
+	return n, mean, math.Sqrt(ss / float64(n-1))
+}
+
+// MeanCI - доверительный интервал среднего уровня confidence в нормальном приближении.
+// При одной оценке интервал вырождается в точку.
+func MeanCI(scores []entity.ScoreCount, confidence float64) (lo, hi float64) {
+	n, mean, sd := Summary(scores)
+	if n < 2 {
+		return mean, mean
+	}
+	half := zQuantile(confidence) * sd / math.Sqrt(float64(n))
+	return mean - half, mean + half
+}
+
+// Distribution - число оценок по score, округлённому до целого на шкале ScoreMin..ScoreMax.
+func Distribution(scores []entity.ScoreCount) []int64 {
+	lo, hi := int(entity.ScoreMin), int(entity.ScoreMax)
+	out := make([]int64, hi-lo+1)
+	for _, s := range scores {
+		b := min(max(int(math.Round(s.Score)), lo), hi)
+		out[b-lo] += s.Count
+	}
+	return out
+}
+
+// MannWhitneyResult - U-статистика для первой выборки, z в нормальном приближении
+// (с поправками на ничьи и непрерывность) и двустороннее p-value.
+type MannWhitneyResult struct {
+	U      float64
+	Z      float64
+	PValue float64
+	// ProbSuperiority - U / (nA * nB)
+	ProbSuperiority float64
+}
+
+// MannWhitney сравнивает две выборки U-критерием Манна-Уитни. Пустая выборка или
+// полностью совпадающие значения дают p-value 1.
+func MannWhitney(a, b []entity.ScoreCount) MannWhitneyResult {
+	type value struct {
+		score  float64
+		ca, cb int64
+	}
+	merged := make(map[float64]*value)
+	var na, nb int64
+	for _, s := range a {
+		v := merged[s.Score]
+		if v == nil {
+			v = &value{score: s.Score}
+			merged[s.Score] = v
+		}
+		v.ca += s.Count
+		na += s.Count
+	}
+	for _, s := range b {
+		v := merged[s.Score]
+		if v == nil {
+			v = &value{score: s.Score}
+			merged[s.Score] = v
+		}
+		v.cb += s.Count
+		nb += s.Count
+	}
+	if na == 0 || nb == 0 {
+		return MannWhitneyResult{PValue: 1, ProbSuperiority: 0.5}
+	}
+
+	values := make([]*value, 0, len(merged))
+	for _, v := range merged {
+		values = append(values, v)
+	}
+	slices.SortFunc(values, func(x, y *value) int { return cmp.Compare(x.score, y.score) })
+
+	// ранги с усреднением по ничьим
+	var rankSumA, ties, seen float64
+	for _, v := range values {
+		t := float64(v.ca + v.cb)
+		rankSumA += float64(v.ca) * (seen + (t+1)/2)
+		ties += t*t*t - t
+		seen += t
+	}
+
+	fa, fb := float64(na), float64(nb)
+	n := fa + fb
+	u := rankSumA - fa*(fa+1)/2
+	res := MannWhitneyResult{U: u, PValue: 1, ProbSuperiority: u / (fa * fb)}
+
+	variance := fa * fb / 12 * ((n + 1) - ties/(n*(n-1)))
+	if variance <= 0 {
+		return res
+	}
+	diff := u - fa*fb/2
+	switch {
+	case diff > 0.5:
+		diff -= 0.5
+	case diff < -0.5:
+		diff += 0.5
+	default:
+		diff = 0
+	}
+	res.Z = diff / math.Sqrt(variance)
+	res.PValue = math.Erfc(math.Abs(res.Z) / math.Sqrt2)
+	return res
+}
+
+// Holm - p-value с поправкой Холма-Бонферрони на множественные сравнения, в том же порядке.
+func Holm(pvalues []float64) []float64 {
+	m := len(pvalues)
+	order := make([]int, m)
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(x, y int) int { return cmp.Compare(pvalues[x], pvalues[y]) })
+
+	out := make([]float64, m)
+	var running float64
+	for k, i := range order {
+		running = max(running, min(1, float64(m-k)*pvalues[i]))
+		out[i] = running
+	}
+	return out
+}
+
+// zQuantile - квантиль стандартного нормального распределения для двустороннего
+// интервала уровня confidence.
+func zQuantile(confidence float64) float64 {
+	return math.Sqrt2 * math.Erfinv(confidence)
+}
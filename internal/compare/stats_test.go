@@ -0,0 +1,148 @@
+package compare
+
+import (
+	"math"
+	"testing"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// counts сворачивает отдельные оценки в счётчики, как их отдаёт репозиторий.
+func counts(scores ...float64) []entity.ScoreCount {
+	var out []entity.ScoreCount
+	for _, s := range scores {
+		if n := len(out); n > 0 && out[n-1].Score == s {
+			out[n-1].Count++
+			continue
+		}
+		out = append(out, entity.ScoreCount{Score: s, Count: 1})
+	}
+	return out
+}
+
+func TestMannWhitney(t *testing.T) {
+	// ожидаемые значения - нормальное приближение с поправками на ничьи и непрерывность,
+	// как wilcox.test(a, b, exact = FALSE, correct = TRUE) в R
+	tests := []struct {
+		name  string
+		a, b  []entity.ScoreCount
+		wantU float64
+		wantZ float64
+		wantP float64
+		wantS float64
+	}{
+		{
+			name:  "complete separation",
+			a:     counts(1, 2, 3, 4, 5),
+			b:     counts(6, 7, 8, 9, 10),
+			wantU: 0, wantZ: -2.50672, wantP: 0.012186, wantS: 0,
+		},
+		{
+			name:  "ties across samples",
+			a:     counts(1, 2, 2, 3),
+			b:     counts(2, 3, 3, 4),
+			wantU: 3, wantZ: -1.36570, wantP: 0.172034, wantS: 0.1875,
+		},
+		{
+			name:  "first sample higher with ties",
+			a:     counts(7, 8, 8, 9, 9, 9, 10),
+			b:     counts(5, 6, 6, 7, 8, 8),
+			wantU: 37.5, wantZ: 2.33767, wantP: 0.019404, wantS: 0.892857,
+		},
+		{
+			name:  "identical samples",
+			a:     counts(3, 5, 7),
+			b:     counts(3, 5, 7),
+			wantU: 4.5, wantZ: 0, wantP: 1, wantS: 0.5,
+		},
+		{
+			name:  "all values tied",
+			a:     counts(8, 8),
+			b:     counts(8, 8, 8),
+			wantU: 3, wantZ: 0, wantP: 1, wantS: 0.5,
+		},
+		{
+			name:  "empty sample",
+			a:     nil,
+			b:     counts(1, 2),
+			wantU: 0, wantZ: 0, wantP: 1, wantS: 0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MannWhitney(tt.a, tt.b)
+			if !near(got.U, tt.wantU, 1e-9) || !near(got.Z, tt.wantZ, 1e-5) ||
+				!near(got.PValue, tt.wantP, 1e-6) || !near(got.ProbSuperiority, tt.wantS, 1e-6) {
+				t.Errorf("MannWhitney() = %+v, want U %v Z %v p %v prob %v",
+					got, tt.wantU, tt.wantZ, tt.wantP, tt.wantS)
+			}
+		})
+	}
+}
+
+func TestMannWhitneySymmetric(t *testing.T) {
+	a, b := counts(1, 2, 2, 3), counts(2, 3, 3, 4)
+	ab, ba := MannWhitney(a, b), MannWhitney(b, a)
+
+	if !near(ab.U+ba.U, 16, 1e-9) || !near(ab.Z, -ba.Z, 1e-12) || !near(ab.PValue, ba.PValue, 1e-12) {
+		t.Errorf("MannWhitney(a, b) = %+v, MannWhitney(b, a) = %+v", ab, ba)
+	}
+}
+
+func TestHolm(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []float64
+		want []float64
+	}{
+		{"empty", []float64{}, []float64{}},
+		{"single", []float64{0.02}, []float64{0.02}},
+		{"keeps input order", []float64{0.01, 0.04, 0.03}, []float64{0.03, 0.06, 0.06}},
+		{"monotone after sorting", []float64{0.04, 0.001, 0.02, 0.03}, []float64{0.06, 0.004, 0.06, 0.06}},
+		{"capped at one", []float64{0.5, 0.6}, []float64{1, 1}},
+		{"equal p-values", []float64{0.01, 0.01}, []float64{0.02, 0.02}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Holm(tt.in)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Holm() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !near(got[i], tt.want[i], 1e-12) {
+					t.Errorf("Holm() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestMeanCI(t *testing.T) {
+	tests := []struct {
+		name           string
+		scores         []entity.ScoreCount
+		confidence     float64
+		wantLo, wantHi float64
+	}{
+		// mean 5, sd sqrt(10/3), 1.959964 * sd / 2
+		{"95 percent", counts(3, 4, 6, 7), 0.95, 3.210806, 6.789194},
+		{"single rating", counts(8), 0.95, 8, 8},
+		{"no ratings", nil, 0.95, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lo, hi := MeanCI(tt.scores, tt.confidence)
+			if !near(lo, tt.wantLo, 1e-5) || !near(hi, tt.wantHi, 1e-5) {
+				t.Errorf("MeanCI() = (%v, %v), want (%v, %v)", lo, hi, tt.wantLo, tt.wantHi)
+			}
+		})
+	}
+}
+
+func near(got, want, eps float64) bool {
+	return math.Abs(got-want) <= eps
+}
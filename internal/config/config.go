@@ -10,40 +10,226 @@ import (
 
 type (
 	Config struct {
-		Env        string      `yaml:"env" env:"ENV" env-default:"local"`
-		PostgreURL postgreURL  `yaml:"postgres"`
-		AppInfo    appStruct   `yaml:"app"`
-		GRPC       grpcStruct  `yaml:"grpc"`
-		Kafka      KafkaConfig `yaml:"kafka"`
+		Env        string           `yaml:"env" env:"ENV" env-default:"local"`
+		PostgreURL postgreURL       `yaml:"postgres"`
+		AppInfo    appStruct        `yaml:"app"`
+		GRPC       grpcStruct       `yaml:"grpc"`
+		HTTP       HTTPConfig       `yaml:"http"`
+		Kafka      KafkaConfig      `yaml:"kafka"`
+		RateLimit  RateLimitConfig  `yaml:"rate_limit"`
+		Validation ValidationConfig `yaml:"validation"`
+		Tracing    TracingConfig    `yaml:"tracing"`
+		Watch      WatchConfig      `yaml:"watch"`
+		Snapshots  SnapshotConfig   `yaml:"snapshots"`
+		Similarity SimilarityConfig `yaml:"similarity"`
+		Recommend  RecommendConfig  `yaml:"recommend"`
+		Normalize  NormalizeConfig  `yaml:"normalize"`
+		Reputation ReputationConfig `yaml:"reputation"`
+		Moderation ModerationConfig `yaml:"moderation"`
+		Freeze     FreezeConfig     `yaml:"freeze"`
+		Embargo    EmbargoConfig    `yaml:"embargo"`
+		Ownership  OwnershipConfig  `yaml:"ownership"`
+		Studio     StudioConfig     `yaml:"studio"`
+		Compare    CompareConfig    `yaml:"compare"`
 	}
 
 	appStruct struct {
-		Name    string `yaml:"name" env-required:"true"`
-		Version string `yaml:"version" env-required:"true"`
+		Name            string        `yaml:"name" env-required:"true"`
+		Version         string        `yaml:"version" env-required:"true"`
+		ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" env-default:"15s"`
 	}
 
 	grpcStruct struct {
 		Address string `yaml:"address" env-required:"true"`
 	}
 
+	HTTPConfig struct {
+		Address           string        `yaml:"address" env:"HTTP_ADDRESS" env-default:":8080"`
+		ReadTimeout       time.Duration `yaml:"read_timeout" env:"HTTP_READ_TIMEOUT" env-default:"5s"`
+		ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"HTTP_READ_HEADER_TIMEOUT" env-default:"2s"`
+		WriteTimeout      time.Duration `yaml:"write_timeout" env:"HTTP_WRITE_TIMEOUT" env-default:"10s"`
+		IdleTimeout       time.Duration `yaml:"idle_timeout" env:"HTTP_IDLE_TIMEOUT" env-default:"60s"`
+	}
+
 	postgreURL struct {
-		URL       string `yaml:"url" env-required:"true"`
-		Host      string `yaml:"host" env-required:"true"`
-		Port      uint16 `yaml:"port" env-required:"true"`
-		Database  string `yaml:"database" env-required:"true"`
-		User      string `yaml:"user" env-required:"true"`
-		Password  string `yaml:"password" env-required:"true"`
-		PgPoolMax uint16 `yaml:"pg_pool_max" env-required:"true"`
+		URL             string        `yaml:"url" env-required:"true"`
+		Host            string        `yaml:"host" env-required:"true"`
+		Port            uint16        `yaml:"port" env-required:"true"`
+		Database        string        `yaml:"database" env-required:"true"`
+		User            string        `yaml:"user" env-required:"true"`
+		Password        string        `yaml:"password" env-required:"true"`
+		PgPoolMax       uint16        `yaml:"pg_pool_max" env-required:"true"`
+		PgPoolMin       uint16        `yaml:"pg_pool_min" env-default:"0"`
+		MaxConnLifetime time.Duration `yaml:"max_conn_lifetime" env-default:"1h"`
+		MaxConnIdleTime time.Duration `yaml:"max_conn_idle_time" env-default:"30m"`
+		ConnAttempts    int           `yaml:"conn_attempts" env-default:"10"`
+		ConnTimeout     time.Duration `yaml:"conn_timeout" env-default:"1s"`
 	}
 
 	KafkaConfig struct {
-		Brokers      []string      `yaml:"brokers"`
-		TopicRatings string        `yaml:"topic_ratings"`
-		GroupID      string        `yaml:"group_id"`
-		DialTimeout  time.Duration `yaml:"dial_timeout"`
-		ReadTimeout  time.Duration `yaml:"read_timeout"`
-		MinBytes     int           `yaml:"min_bytes"`
-		MaxBytes     int           `yaml:"max_bytes"`
+		Brokers      []string `yaml:"brokers"`
+		TopicRatings string   `yaml:"topic_ratings"`
+		// TopicDLQ - куда уходят сообщения, которые не удалось обработать; пустой - DLQ выключен
+		TopicDLQ       string        `yaml:"topic_dlq"`
+		GroupID        string        `yaml:"group_id"`
+		DialTimeout    time.Duration `yaml:"dial_timeout" env-default:"10s"`
+		ReadTimeout    time.Duration `yaml:"read_timeout" env-default:"10s"`
+		MinBytes       int           `yaml:"min_bytes" env-default:"1"`
+		MaxBytes       int           `yaml:"max_bytes" env-default:"1048576"`
+		MaxWait        time.Duration `yaml:"max_wait" env-default:"1s"`
+		CommitInterval time.Duration `yaml:"commit_interval" env-default:"0s"`
+		// StartOffset - "first" или "last": откуда читать новой consumer group
+		StartOffset string `yaml:"start_offset" env-default:"first"`
+		// TopicUserEvents - события аккаунтов для весов оценщиков; пустой - не читать
+		TopicUserEvents string `yaml:"topic_user_events"`
+		// TopicOwnership - владение играми и наигранное время; пустой - не читать
+		TopicOwnership string `yaml:"topic_ownership"`
+		// TopicCatalog - изменения каталога игр для фильтров топа; пустой - не читать
+		TopicCatalog string `yaml:"topic_catalog"`
+	}
+
+	RateLimitConfig struct {
+		Enabled       bool          `yaml:"enabled" env:"RATE_LIMIT_ENABLED" env-default:"false"`
+		Store         string        `yaml:"store" env:"RATE_LIMIT_STORE" env-default:"memory"`
+		RedisAddr     string        `yaml:"redis_addr" env:"RATE_LIMIT_REDIS_ADDR"`
+		RedisPassword string        `yaml:"redis_password" env:"RATE_LIMIT_REDIS_PASSWORD"`
+		RedisDB       int           `yaml:"redis_db" env:"RATE_LIMIT_REDIS_DB" env-default:"0"`
+		PerUser       RateLimitRule `yaml:"per_user"`
+		PerClient     RateLimitRule `yaml:"per_client"`
+		Global        RateLimitRule `yaml:"global"`
+	}
+
+	ValidationConfig struct {
+		// DefaultScale - шкала оценок, если клиент её не указал; AllowedScales пустой - разрешены все.
+		DefaultScale  string   `yaml:"default_scale" env:"DEFAULT_SCALE" env-default:"ten"`
+		AllowedScales []string `yaml:"allowed_scales" env:"ALLOWED_SCALES" env-separator:","`
+		// KnownGames/KnownGamesFile - необязательный allowlist игр; пустой - принимаем любые game_id.
+		KnownGames     []string `yaml:"known_games" env:"KNOWN_GAMES" env-separator:","`
+		KnownGamesFile string   `yaml:"known_games_file" env:"KNOWN_GAMES_FILE"`
+	}
+
+	TracingConfig struct {
+		Enabled bool `yaml:"enabled" env:"TRACING_ENABLED" env-default:"false"`
+		// Exporter - "otlp" (gRPC) или "stdout" для локального запуска
+		Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER" env-default:"otlp"`
+		Endpoint    string  `yaml:"endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT" env-default:"localhost:4317"`
+		Insecure    bool    `yaml:"insecure" env:"TRACING_INSECURE" env-default:"true"`
+		SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+	}
+
+	// WatchConfig - живые обновления рейтингов (LISTEN/NOTIFY -> gRPC-стрим и SSE).
+	WatchConfig struct {
+		Enabled bool `yaml:"enabled" env:"WATCH_ENABLED" env-default:"true"`
+		// CoalesceInterval - не чаще одного пакета обновлений подписчику за интервал; обновления одной игры схлопываются.
+		CoalesceInterval time.Duration `yaml:"coalesce_interval" env:"WATCH_COALESCE_INTERVAL" env-default:"250ms"`
+		// MaxPending - сколько разных игр может ждать отправки; медленного подписчика сверх лимита отключаем.
+		MaxPending        int           `yaml:"max_pending" env:"WATCH_MAX_PENDING" env-default:"1000"`
+		MaxSubscribers    int           `yaml:"max_subscribers" env:"WATCH_MAX_SUBSCRIBERS" env-default:"1000"`
+		MaxGames          int           `yaml:"max_games" env:"WATCH_MAX_GAMES" env-default:"100"`
+		HeartbeatInterval time.Duration `yaml:"heartbeat_interval" env:"WATCH_HEARTBEAT_INTERVAL" env-default:"15s"`
+	}
+
+	// SnapshotConfig - периодические снимки агрегатов для GetRatingTimeline.
+	SnapshotConfig struct {
+		Enabled  bool          `yaml:"enabled" env:"SNAPSHOTS_ENABLED" env-default:"true"`
+		Interval time.Duration `yaml:"interval" env:"SNAPSHOTS_INTERVAL" env-default:"10m"`
+		// Hourly - кроме дневных снимков писать и почасовые; они хранятся HourlyRetention.
+		Hourly          bool          `yaml:"hourly" env:"SNAPSHOTS_HOURLY" env-default:"false"`
+		HourlyRetention time.Duration `yaml:"hourly_retention" env:"SNAPSHOTS_HOURLY_RETENTION" env-default:"720h"`
+		// MaxPoints - ограничение на число точек в одном ответе GetRatingTimeline.
+		MaxPoints int `yaml:"max_points" env:"SNAPSHOTS_MAX_POINTS" env-default:"1000"`
+	}
+
+	// SimilarityConfig - item-item похожесть игр для GetSimilarGames.
+	SimilarityConfig struct {
+		Enabled bool `yaml:"enabled" env:"SIMILARITY_ENABLED" env-default:"true"`
+		// Method - adjusted_cosine или pearson
+		Method      string `yaml:"method" env:"SIMILARITY_METHOD" env-default:"adjusted_cosine"`
+		MinCoRaters int    `yaml:"min_co_raters" env:"SIMILARITY_MIN_CO_RATERS" env-default:"3"`
+		TopK        int    `yaml:"top_k" env:"SIMILARITY_TOP_K" env-default:"50"`
+		// RefreshInterval - как часто пересчитывать игры, у которых менялись оценки;
+		// за один проход берётся не больше RefreshBatch игр на транзакцию.
+		RefreshInterval time.Duration `yaml:"refresh_interval" env:"SIMILARITY_REFRESH_INTERVAL" env-default:"5m"`
+		RefreshBatch    int           `yaml:"refresh_batch" env:"SIMILARITY_REFRESH_BATCH" env-default:"100"`
+	}
+
+	// RecommendConfig - RecommendGames поверх соседей из SimilarityConfig.
+	RecommendConfig struct {
+		// MaxLimit - сколько игр можно запросить за раз
+		MaxLimit int `yaml:"max_limit" env:"RECOMMEND_MAX_LIMIT" env-default:"100"`
+		// MinNeighbors - сколько оценённых пользователем соседей нужно, чтобы предсказать оценку игры
+		MinNeighbors int `yaml:"min_neighbors" env:"RECOMMEND_MIN_NEIGHBORS" env-default:"2"`
+		// PopularityPrior - сколько "виртуальных" оценок со средним по всем играм добавляется
+		// к каждой игре при ранжировании по популярности
+		PopularityPrior float64 `yaml:"popularity_prior" env:"RECOMMEND_POPULARITY_PRIOR" env-default:"10"`
+	}
+
+	// NormalizeConfig - нормализованный рейтинг игр с поправкой на манеру оценивания пользователей.
+	NormalizeConfig struct {
+		Enabled bool `yaml:"enabled" env:"NORMALIZE_ENABLED" env-default:"false"`
+		// Method - mean_center или zscore
+		Method         string        `yaml:"method" env:"NORMALIZE_METHOD" env-default:"mean_center"`
+		MinUserRatings int           `yaml:"min_user_ratings" env:"NORMALIZE_MIN_USER_RATINGS" env-default:"3"`
+		Interval       time.Duration `yaml:"interval" env:"NORMALIZE_INTERVAL" env-default:"15m"`
+	}
+
+	// ReputationConfig - веса оценщиков для взвешенного агрегата (см. entity.ReputationParams).
+	ReputationConfig struct {
+		Enabled             bool          `yaml:"enabled" env:"REPUTATION_ENABLED" env-default:"true"`
+		Interval            time.Duration `yaml:"interval" env:"REPUTATION_INTERVAL" env-default:"30m"`
+		AgeFull             time.Duration `yaml:"age_full" env-default:"720h"`
+		HistoryFull         int           `yaml:"history_full" env-default:"20"`
+		MinConsensusRatings int           `yaml:"min_consensus_ratings" env-default:"5"`
+		AgreementScale      float64       `yaml:"agreement_scale" env-default:"3"`
+		MinWeight           float64       `yaml:"min_weight" env-default:"0.1"`
+		FlaggedFactor       float64       `yaml:"flagged_factor" env-default:"0.1"`
+		PastFlagFactor      float64       `yaml:"past_flag_factor" env-default:"0.5"`
+		ChangeThreshold     float64       `yaml:"change_threshold" env-default:"0.01"`
+	}
+
+	ModerationConfig struct {
+		// MaxPageSize - сколько записей журнала модерации можно запросить за раз
+		MaxPageSize int `yaml:"max_page_size" env:"MODERATION_MAX_PAGE_SIZE" env-default:"100"`
+	}
+
+	FreezeConfig struct {
+		// ExpiryInterval - как часто убирать истёкшие заморозки и рассылать подписчикам
+		// накопленные за заморозку изменения
+		ExpiryInterval time.Duration `yaml:"expiry_interval" env:"FREEZE_EXPIRY_INTERVAL" env-default:"1m"`
+	}
+
+	// EmbargoConfig - оценки игр до даты выхода (game_releases).
+	EmbargoConfig struct {
+		// DefaultPolicy - "reject" или "park" для игр без своей политики
+		DefaultPolicy string `yaml:"default_policy" env:"EMBARGO_DEFAULT_POLICY" env-default:"reject"`
+		// ReleaseInterval - как часто переносить отложенные оценки вышедших игр в рейтинг
+		ReleaseInterval time.Duration `yaml:"release_interval" env:"EMBARGO_RELEASE_INTERVAL" env-default:"1m"`
+	}
+
+	OwnershipConfig struct {
+		// MinPlaytime - сколько нужно наиграть во владеемую игру, чтобы оценка считалась
+		// оценкой проверенного игрока; 0 - достаточно владения
+		MinPlaytime time.Duration `yaml:"min_playtime" env:"OWNERSHIP_MIN_PLAYTIME" env-default:"0s"`
+	}
+
+	StudioConfig struct {
+		// MaxPageSize - сколько студий или игр студии можно запросить за раз
+		MaxPageSize int `yaml:"max_page_size" env:"STUDIO_MAX_PAGE_SIZE" env-default:"100"`
+	}
+
+	CompareConfig struct {
+		// MaxGames - сколько игр можно сравнить за раз; пар получается MaxGames*(MaxGames-1)/2
+		MaxGames int `yaml:"max_games" env:"COMPARE_MAX_GAMES" env-default:"10"`
+		// Confidence - уровень доверительного интервала среднего
+		Confidence float64 `yaml:"confidence" env:"COMPARE_CONFIDENCE" env-default:"0.95"`
+		// Alpha - порог значимости различия пары после поправки Холма
+		Alpha float64 `yaml:"alpha" env:"COMPARE_ALPHA" env-default:"0.05"`
+	}
+
+	// RateLimitRule - token bucket: Rate токенов в секунду, не больше Burst. Rate == 0 отключает правило.
+	RateLimitRule struct {
+		Rate  float64 `yaml:"rate"`
+		Burst int     `yaml:"burst"`
 	}
 )
 
@@ -64,5 +250,9 @@ func MustLoad() *Config {
 		log.Fatal("Cant read config", err)
 	}
 
+	if err := config.Validate(); err != nil {
+		log.Fatalf("invalid config:\n%v", err)
+	}
+
 	return &config
 }
@@ -0,0 +1,217 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+// Validate проверяет конфиг целиком и возвращает все ошибки сразу, по одной на строку.
+func (c *Config) Validate() error {
+	var errs []error
+	add := func(field, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: "+format, append([]any{field}, args...)...))
+	}
+	positive := func(field string, d time.Duration) {
+		if d <= 0 {
+			add(field, "must be positive, got %s", d)
+		}
+	}
+	address := func(field, addr string) {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			add(field, "invalid address %q: %v", addr, err)
+		}
+	}
+
+	positive("app.shutdown_timeout", c.AppInfo.ShutdownTimeout)
+
+	address("grpc.address", c.GRPC.Address)
+
+	address("http.address", c.HTTP.Address)
+	positive("http.read_timeout", c.HTTP.ReadTimeout)
+	positive("http.read_header_timeout", c.HTTP.ReadHeaderTimeout)
+	if c.HTTP.WriteTimeout < 0 {
+		add("http.write_timeout", "must not be negative, got %s", c.HTTP.WriteTimeout)
+	}
+	positive("http.idle_timeout", c.HTTP.IdleTimeout)
+
+	if c.PostgreURL.PgPoolMax == 0 {
+		add("postgres.pg_pool_max", "must be positive")
+	}
+	if c.PostgreURL.PgPoolMin > c.PostgreURL.PgPoolMax {
+		add("postgres.pg_pool_min", "%d is greater than pg_pool_max %d", c.PostgreURL.PgPoolMin, c.PostgreURL.PgPoolMax)
+	}
+	positive("postgres.max_conn_lifetime", c.PostgreURL.MaxConnLifetime)
+	positive("postgres.max_conn_idle_time", c.PostgreURL.MaxConnIdleTime)
+	if c.PostgreURL.ConnAttempts <= 0 {
+		add("postgres.conn_attempts", "must be positive, got %d", c.PostgreURL.ConnAttempts)
+	}
+	positive("postgres.conn_timeout", c.PostgreURL.ConnTimeout)
+
+	if len(c.Kafka.Brokers) == 0 {
+		add("kafka.brokers", "at least one broker is required")
+	}
+	if c.Kafka.TopicRatings == "" {
+		add("kafka.topic_ratings", "is required")
+	}
+	if c.Kafka.GroupID == "" {
+		add("kafka.group_id", "is required")
+	}
+	positive("kafka.dial_timeout", c.Kafka.DialTimeout)
+	positive("kafka.read_timeout", c.Kafka.ReadTimeout)
+	positive("kafka.max_wait", c.Kafka.MaxWait)
+	if c.Kafka.MinBytes <= 0 || c.Kafka.MaxBytes < c.Kafka.MinBytes {
+		add("kafka.min_bytes/max_bytes", "need 0 < min_bytes <= max_bytes, got %d and %d", c.Kafka.MinBytes, c.Kafka.MaxBytes)
+	}
+	if c.Kafka.CommitInterval < 0 {
+		add("kafka.commit_interval", "must not be negative, got %s", c.Kafka.CommitInterval)
+	}
+	if c.Kafka.StartOffset != "first" && c.Kafka.StartOffset != "last" {
+		add("kafka.start_offset", `must be "first" or "last", got %q`, c.Kafka.StartOffset)
+	}
+
+	if c.RateLimit.Enabled {
+		switch c.RateLimit.Store {
+		case "memory":
+		case "redis":
+			if c.RateLimit.RedisAddr == "" {
+				add("rate_limit.redis_addr", "is required for redis store")
+			}
+		default:
+			add("rate_limit.store", `must be "memory" or "redis", got %q`, c.RateLimit.Store)
+		}
+		rules := []struct {
+			name string
+			rule RateLimitRule
+		}{
+			{"per_user", c.RateLimit.PerUser},
+			{"per_client", c.RateLimit.PerClient},
+			{"global", c.RateLimit.Global},
+		}
+		for _, r := range rules {
+			if r.rule.Rate < 0 || (r.rule.Rate > 0 && r.rule.Burst < 1) {
+				add("rate_limit."+r.name, "need rate >= 0 and burst >= 1, got %v/%d", r.rule.Rate, r.rule.Burst)
+			}
+		}
+	}
+
+	if c.Tracing.Enabled {
+		if c.Tracing.Exporter != "otlp" && c.Tracing.Exporter != "stdout" {
+			add("tracing.exporter", `must be "otlp" or "stdout", got %q`, c.Tracing.Exporter)
+		}
+		if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+			add("tracing.sample_ratio", "must be within [0, 1], got %v", c.Tracing.SampleRatio)
+		}
+	}
+
+	if c.Watch.Enabled {
+		if c.Watch.CoalesceInterval < 0 {
+			add("watch.coalesce_interval", "must not be negative, got %s", c.Watch.CoalesceInterval)
+		}
+		if c.Watch.MaxPending <= 0 || c.Watch.MaxSubscribers <= 0 || c.Watch.MaxGames <= 0 {
+			add("watch.max_pending/max_subscribers/max_games", "must be positive, got %d, %d and %d",
+				c.Watch.MaxPending, c.Watch.MaxSubscribers, c.Watch.MaxGames)
+		}
+		positive("watch.heartbeat_interval", c.Watch.HeartbeatInterval)
+	}
+
+	if c.Snapshots.Enabled {
+		positive("snapshots.interval", c.Snapshots.Interval)
+		if c.Snapshots.Hourly {
+			positive("snapshots.hourly_retention", c.Snapshots.HourlyRetention)
+		}
+	}
+	if c.Snapshots.MaxPoints <= 0 {
+		add("snapshots.max_points", "must be positive, got %d", c.Snapshots.MaxPoints)
+	}
+
+	switch c.Similarity.Method {
+	case "adjusted_cosine", "pearson":
+	default:
+		add("similarity.method", "must be adjusted_cosine or pearson, got %q", c.Similarity.Method)
+	}
+	if c.Similarity.MinCoRaters < 2 {
+		add("similarity.min_co_raters", "must be at least 2, got %d", c.Similarity.MinCoRaters)
+	}
+	if c.Similarity.TopK <= 0 {
+		add("similarity.top_k", "must be positive, got %d", c.Similarity.TopK)
+	}
+	if c.Similarity.Enabled {
+		positive("similarity.refresh_interval", c.Similarity.RefreshInterval)
+		if c.Similarity.RefreshBatch <= 0 {
+			add("similarity.refresh_batch", "must be positive, got %d", c.Similarity.RefreshBatch)
+		}
+	}
+
+	if c.Recommend.MaxLimit <= 0 {
+		add("recommend.max_limit", "must be positive, got %d", c.Recommend.MaxLimit)
+	}
+	if c.Recommend.MinNeighbors <= 0 {
+		add("recommend.min_neighbors", "must be positive, got %d", c.Recommend.MinNeighbors)
+	}
+	if c.Recommend.PopularityPrior < 0 {
+		add("recommend.popularity_prior", "must not be negative, got %g", c.Recommend.PopularityPrior)
+	}
+
+	switch c.Normalize.Method {
+	case "mean_center", "zscore":
+	default:
+		add("normalize.method", "must be mean_center or zscore, got %q", c.Normalize.Method)
+	}
+	if c.Normalize.MinUserRatings < 2 {
+		add("normalize.min_user_ratings", "must be at least 2, got %d", c.Normalize.MinUserRatings)
+	}
+	if c.Normalize.Enabled {
+		positive("normalize.interval", c.Normalize.Interval)
+	}
+
+	if c.Reputation.Enabled {
+		positive("reputation.interval", c.Reputation.Interval)
+	}
+	positive("reputation.age_full", c.Reputation.AgeFull)
+	if c.Reputation.HistoryFull <= 0 {
+		add("reputation.history_full", "must be positive, got %d", c.Reputation.HistoryFull)
+	}
+	if c.Reputation.AgreementScale <= 0 {
+		add("reputation.agreement_scale", "must be positive, got %g", c.Reputation.AgreementScale)
+	}
+	fraction := func(field string, v float64) {
+		if v < 0 || v > 1 {
+			add(field, "must be between 0 and 1, got %g", v)
+		}
+	}
+	fraction("reputation.min_weight", c.Reputation.MinWeight)
+	fraction("reputation.flagged_factor", c.Reputation.FlaggedFactor)
+	fraction("reputation.past_flag_factor", c.Reputation.PastFlagFactor)
+	fraction("reputation.change_threshold", c.Reputation.ChangeThreshold)
+
+	if c.Moderation.MaxPageSize <= 0 {
+		add("moderation.max_page_size", "must be positive, got %d", c.Moderation.MaxPageSize)
+	}
+
+	positive("freeze.expiry_interval", c.Freeze.ExpiryInterval)
+	switch c.Embargo.DefaultPolicy {
+	case "reject", "park":
+	default:
+		add("embargo.default_policy", "must be reject or park, got %q", c.Embargo.DefaultPolicy)
+	}
+	positive("embargo.release_interval", c.Embargo.ReleaseInterval)
+	if c.Ownership.MinPlaytime < 0 {
+		add("ownership.min_playtime", "must not be negative, got %s", c.Ownership.MinPlaytime)
+	}
+	if c.Studio.MaxPageSize <= 0 {
+		add("studio.max_page_size", "must be positive, got %d", c.Studio.MaxPageSize)
+	}
+	if c.Compare.MaxGames < 2 {
+		add("compare.max_games", "must be at least 2, got %d", c.Compare.MaxGames)
+	}
+	if c.Compare.Confidence <= 0 || c.Compare.Confidence >= 1 {
+		add("compare.confidence", "must be between 0 and 1 exclusive, got %g", c.Compare.Confidence)
+	}
+	if c.Compare.Alpha <= 0 || c.Compare.Alpha >= 1 {
+		add("compare.alpha", "must be between 0 and 1 exclusive, got %g", c.Compare.Alpha)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// CatalogEventType - изменение игры в каталоге.
+type CatalogEventType string
+
+const (
+	CatalogUpserted CatalogEventType = "upserted"
+	CatalogDeleted  CatalogEventType = "deleted"
+)
+
+func ParseCatalogEventType(s string) (CatalogEventType, error) {
+	switch t := CatalogEventType(s); t {
+	case CatalogUpserted, CatalogDeleted:
+		return t, nil
+	default:
+		return "", fmt.Errorf("unknown catalog event type %q", s)
+	}
+}
+
+// CatalogMessage - сообщение топика каталога; в upserted - полное состояние игры.
+type CatalogMessage struct {
+	GameID      string     `json:"game_id"`
+	Type        string     `json:"type"`
+	Genre       string     `json:"genre,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	ReleaseYear int32      `json:"release_year,omitempty"`
+	Publisher   string     `json:"publisher,omitempty"`
+	Developer   string     `json:"developer,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// GameMeta - метаданные игры из каталога. Genre и Tags - в нижнем регистре,
+// ReleaseYear == 0 - год неизвестен.
+type GameMeta struct {
+	GameID      string
+	Genre       string
+	Tags        []string
+	ReleaseYear int32
+	Publisher   string
+	Developer   string
+	Deleted     bool
+	UpdatedAt   time.Time
+}
+
+// TopFilter - ограничение топа по метаданным каталога; пустые поля не фильтруют.
+// Игра должна иметь все теги из Tags. С непустым фильтром в топ попадают только игры,
+// известные каталогу.
+type TopFilter struct {
+	Genre       string
+	Tags        []string
+	ReleaseYear int32
+	Publisher   string
+}
+
+func (f TopFilter) IsZero() bool {
+	return f.Genre == "" && len(f.Tags) == 0 && f.ReleaseYear == 0 && f.Publisher == ""
+}
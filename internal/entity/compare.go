@@ -0,0 +1,63 @@
+package entity
+
+// ScoreCount - сколько оценок игры имеют ровно такой score (каноническая шкала).
+type ScoreCount struct {
+	Score float64
+	Count int64
+}
+
+// GameScores - все оценки игры в виде счётчиков по значениям и место игры в общем топе
+// по среднему (как в GetTopGames). У замороженной игры Scores пусто, а AverageRating и
+// RatingsCount - из снимка: её текущие оценки скрыты до разморозки.
+type GameScores struct {
+	GameID        string
+	Scores        []ScoreCount
+	Rank          int64
+	Frozen        bool
+	AverageRating float64
+	RatingsCount  int64
+}
+
+// CompareParams - параметры статистики сравнения: уровень доверительного интервала
+// среднего и порог значимости различий (после поправки Холма на число пар).
+type CompareParams struct {
+	Confidence float64
+	Alpha      float64
+}
+
+// GameComparison - игра в сравнении. Distribution[i] - число оценок со score,
+// округлённым до i+1; CILow..CIHigh - доверительный интервал среднего. Для замороженной
+// игры (Frozen) есть только среднее, число оценок и место из снимка, в пары она не входит.
+type GameComparison struct {
+	GameID        string
+	AverageRating float64
+	RatingsCount  int64
+	Distribution  []int64
+	Rank          int64
+	CILow         float64
+	CIHigh        float64
+	Frozen        bool
+}
+
+// PairComparison - различие оценок двух игр по Манну-Уитни. ProbSuperiority - оценка
+// вероятности того, что случайная оценка A выше случайной оценки B (ничьи - пополам);
+// PAdjusted - p-value с поправкой Холма на все пары сравнения.
+type PairComparison struct {
+	GameA           string
+	GameB           string
+	MeanDifference  float64
+	U               float64
+	Z               float64
+	ProbSuperiority float64
+	PValue          float64
+	PAdjusted       float64
+	Significant     bool
+}
+
+type Comparison struct {
+	Games  []GameComparison
+	Pairs  []PairComparison
+	Params CompareParams
+	// Scale - шкала, на которую спроецированы средние, интервалы и разности средних
+	Scale Scale
+}
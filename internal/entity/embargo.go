@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// EmbargoPolicy - что делать с оценкой игры, которая ещё не вышла.
+type EmbargoPolicy string
+
+const (
+	// EmbargoReject - отклонить оценку с EmbargoError
+	EmbargoReject EmbargoPolicy = "reject"
+	// EmbargoPark - сохранить в стороне и учесть после выхода игры
+	EmbargoPark EmbargoPolicy = "park"
+)
+
+func ParseEmbargoPolicy(s string) (EmbargoPolicy, error) {
+	switch p := EmbargoPolicy(s); p {
+	case EmbargoReject, EmbargoPark:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown embargo policy %q", s)
+	}
+}
+
+// GameRelease - дата выхода игры. Policy пустая - политика из конфига.
+type GameRelease struct {
+	GameID    string
+	ReleaseAt time.Time
+	Policy    EmbargoPolicy
+	UpdatedAt time.Time
+}
+
+// EmbargoExemption - пользователь, чьи оценки принимаются до выхода игры (пресса, обозреватели).
+type EmbargoExemption struct {
+	UserID    string
+	GrantedBy string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// EmbargoError - оценка отклонена: игра выходит только в ReleaseAt.
+type EmbargoError struct {
+	GameID    string
+	ReleaseAt time.Time
+}
+
+func (e *EmbargoError) Error() string {
+	return fmt.Sprintf("game %s is not released until %s", e.GameID, e.ReleaseAt.UTC().Format(time.RFC3339))
+}
+
+func (e *EmbargoError) Unwrap() error {
+	return ErrEmbargoed
+}
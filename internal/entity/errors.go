@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrGameNotFound    = errors.New("game not found")
+	ErrInvalidUUID     = errors.New("entered uuid is invalid")
+	ErrInvalidScore    = errors.New("score is out of range")
+	ErrRequired        = errors.New("field is required")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrUnknownGame     = errors.New("game is not in the catalog")
+	ErrConflict        = errors.New("conflicting concurrent update")
+	ErrUnavailable     = errors.New("service temporarily unavailable")
+	ErrRateLimited     = errors.New("rate limit exceeded")
+	ErrSlowConsumer    = errors.New("subscriber is too slow")
+	ErrNotFrozen       = errors.New("game is not frozen")
+	ErrEmbargoed       = errors.New("game is not released yet")
+	ErrStudioNotFound  = errors.New("studio not found")
+	ErrNotExempt       = errors.New("user is not exempt from embargo")
+)
+
+// FieldError - ошибка конкретного поля запроса. Err - одна из ошибок выше.
+type FieldError struct {
+	Field       string
+	Err         error
+	Description string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Description
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError собирает все невалидные поля запроса сразу.
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		parts = append(parts, f.Error())
+	}
+	return "validation failed: " + strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		errs = append(errs, f)
+	}
+	return errs
+}
+
+// Add добавляет ошибку поля; удобно для накопления в валидаторах.
+func (e *ValidationError) Add(field string, err error, description string) {
+	e.Fields = append(e.Fields, &FieldError{Field: field, Err: err, Description: description})
+}
+
+// OrNil возвращает nil, если ошибок полей нет.
+func (e *ValidationError) OrNil() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+// RetryableError - ошибка, после которой клиенту имеет смысл повторить запрос через RetryAfter.
+// Err - ErrConflict, ErrUnavailable или ErrRateLimited.
+type RetryableError struct {
+	Err        error
+	RetryAfter time.Duration
+	Reason     string
+}
+
+func (e *RetryableError) Error() string {
+	if e.Reason == "" {
+		return e.Err.Error()
+	}
+	return e.Err.Error() + ": " + e.Reason
+}
+
+func (e *RetryableError) Unwrap() error {
+	return e.Err
+}
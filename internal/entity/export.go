@@ -0,0 +1,45 @@
+package entity
+
+import "time"
+
+// Dataset - таблица, которую можно выгрузить.
+type Dataset string
+
+const (
+	DatasetRatings     Dataset = "ratings"
+	DatasetGameRatings Dataset = "game_ratings"
+)
+
+// ExportFilter - пустые поля не ограничивают выборку. From/To - полуинтервал
+// [From, To) по ratings.created_at; на game_ratings действует только GameIDs.
+type ExportFilter struct {
+	Datasets []Dataset
+	From     time.Time
+	To       time.Time
+	GameIDs  []string
+}
+
+type ExportRating struct {
+	UserID    string
+	GameID    string
+	Rating    int32
+	Scale     Scale
+	Score     float64
+	CreatedAt time.Time
+}
+
+type ExportGameRating struct {
+	GameID        string
+	RatingsCount  int64
+	RatingsSum    float64
+	AverageRating float64
+}
+
+// ExportSink получает строки выгрузки. Каждый набор данных обрамлён Begin/End;
+// наборы идут по очереди, пустые тоже открываются и закрываются.
+type ExportSink interface {
+	Begin(ds Dataset) error
+	WriteRating(ExportRating) error
+	WriteGameRating(ExportGameRating) error
+	End(ds Dataset) error
+}
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+// GameFreeze - заморозка публичного рейтинга игры. Snapshot - рейтинг на момент
+// заморозки, его отдают GetGameRating и топ, пока заморозка действует.
+type GameFreeze struct {
+	GameID   string
+	FrozenAt time.Time
+	// ExpiresAt - нулевое время: до ручного снятия
+	ExpiresAt time.Time
+	Moderator string
+	Reason    string
+	Snapshot  GameRating
+}
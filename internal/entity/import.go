@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+)
+
+// ImportRecord - строка файла импорта. Line - номер строки в исходном файле
+// (для JSON-массива - строка, где начинается объект).
+type ImportRecord struct {
+	Line      int
+	GameID    string
+	UserID    string
+	Rating    int32
+	Scale     string
+	CreatedAt *time.Time
+}
+
+// ImportRow - прошедшая валидацию запись, готовая к загрузке.
+type ImportRow struct {
+	Line      int
+	Rating    Rating
+	CreatedAt *time.Time
+}
+
+// LineError - строку файла не удалось разобрать или она не прошла валидацию.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return "line " + strconv.Itoa(e.Line) + ": " + e.Err.Error()
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// ImportStats - результат слияния staging-таблицы с ratings.
+type ImportStats struct {
+	Inserted int64
+	Updated  int64
+	Games    int64
+	// Parked - строки неизданных игр, отложенные в parked_ratings до выхода.
+	Parked int64
+	// Embargoed - строки неизданных игр, отклонённые политикой reject.
+	Embargoed []*LineError
+}
+
+type ImportResult struct {
+	ImportStats
+	Total    int
+	Rejected []*LineError
+	// Duplicates - строки, перекрытые более поздней строкой с той же парой (user_id, game_id).
+	Duplicates int
+	DryRun     bool
+}
@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+type ModerationActionType string
+
+const (
+	ModerationHide   ModerationActionType = "hide"
+	ModerationUnhide ModerationActionType = "unhide"
+)
+
+// ModerationTarget - какие оценки затрагивает действие: все оценки UserID, только игры
+// GameID, если задана, поставленные в [From, To) (нулевое время - без границы).
+// Одна оценка - UserID и GameID без окна.
+type ModerationTarget struct {
+	UserID string
+	GameID string
+	From   time.Time
+	To     time.Time
+}
+
+// ModerationAction - запись журнала модерации.
+type ModerationAction struct {
+	ID        int64
+	Type      ModerationActionType
+	Moderator string
+	Reason    string
+	Target    ModerationTarget
+	// Affected - сколько оценок скрыто или возвращено
+	Affected  int64
+	CreatedAt time.Time
+	// Games - игры, чьи агрегаты пересчитаны; в журнале не хранится
+	Games []string
+}
+
+// ModerationFilter - выборка журнала, новые записи первыми. BeforeID > 0 - страница
+// после записи с этим id.
+type ModerationFilter struct {
+	UserID    string
+	GameID    string
+	Moderator string
+	BeforeID  int64
+	Limit     int
+}
@@ -0,0 +1,51 @@
+package entity
+
+import "fmt"
+
+// NormalizationMethod - как оценка пользователя приводится к его манере ставить оценки.
+type NormalizationMethod string
+
+const (
+	// NormalizeMeanCenter - отклонение от средней оценки пользователя.
+	NormalizeMeanCenter NormalizationMethod = "mean_center"
+	// NormalizeZScore - отклонение от средней в стандартных отклонениях пользователя.
+	NormalizeZScore NormalizationMethod = "zscore"
+)
+
+func ParseNormalizationMethod(s string) (NormalizationMethod, error) {
+	switch m := NormalizationMethod(s); m {
+	case NormalizeMeanCenter, NormalizeZScore:
+		return m, nil
+	default:
+		return "", fmt.Errorf("unknown normalization method %q", s)
+	}
+}
+
+// NormalizationParams - у пользователей с меньше чем MinUserRatings оценками своя средняя
+// ненадёжна, их оценки берутся относительно общей средней по всем оценкам.
+type NormalizationParams struct {
+	Method         NormalizationMethod
+	MinUserRatings int
+}
+
+// TopOrder - по какому рейтингу сортировать GetTopGames.
+type TopOrder string
+
+const (
+	TopOrderAverage    TopOrder = "average"
+	TopOrderNormalized TopOrder = "normalized"
+	TopOrderWeighted   TopOrder = "weighted"
+	TopOrderVerified   TopOrder = "verified"
+)
+
+// ParseTopOrder - пустая строка означает TopOrderAverage.
+func ParseTopOrder(s string) (TopOrder, error) {
+	switch o := TopOrder(s); o {
+	case "":
+		return TopOrderAverage, nil
+	case TopOrderAverage, TopOrderNormalized, TopOrderWeighted, TopOrderVerified:
+		return o, nil
+	default:
+		return "", fmt.Errorf("unknown order %q", s)
+	}
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// OwnershipEventType - событие магазина/лаунчера о игре пользователя.
+type OwnershipEventType string
+
+const (
+	OwnershipAcquired OwnershipEventType = "acquired"
+	// OwnershipRevoked - возврат денег, отзыв ключа и т.п.
+	OwnershipRevoked OwnershipEventType = "revoked"
+	// OwnershipPlaytime - суммарное наигранное время на момент события
+	OwnershipPlaytime OwnershipEventType = "playtime"
+)
+
+func ParseOwnershipEventType(s string) (OwnershipEventType, error) {
+	switch t := OwnershipEventType(s); t {
+	case OwnershipAcquired, OwnershipRevoked, OwnershipPlaytime:
+		return t, nil
+	default:
+		return "", fmt.Errorf("unknown ownership event type %q", s)
+	}
+}
+
+// OwnershipMessage - сообщение топика владения.
+type OwnershipMessage struct {
+	UserID          string     `json:"user_id"`
+	GameID          string     `json:"game_id"`
+	Type            string     `json:"type"`
+	PlaytimeMinutes int64      `json:"playtime_minutes,omitempty"`
+	OccurredAt      *time.Time `json:"occurred_at,omitempty"`
+}
+
+type OwnershipEvent struct {
+	UserID          string
+	GameID          string
+	Type            OwnershipEventType
+	PlaytimeMinutes int64
+	OccurredAt      time.Time
+}
+
+// PlayerGame - что известно о владении игрой. Verified - оценки пользователя этой игры
+// идут в агрегат проверенных игроков.
+type PlayerGame struct {
+	UserID          string
+	GameID          string
+	Owned           bool
+	PlaytimeMinutes int64
+	Verified        bool
+	UpdatedAt       time.Time
+}
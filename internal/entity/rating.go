@@ -1,16 +1,37 @@
 package entity
 
-import "errors"
-
-var (
-	ErrGameNotFound = errors.New("game not found")
-	ErrInvalidUUID  = errors.New("entered uuid is invalid")
+import (
+	"context"
+	"time"
 )
 
 type GameRating struct {
 	GameId        string
 	AverageRating float64
 	RatingsCount  int64
+	// NormalizedRating - среднее с поправкой на манеру оценивания пользователей, на той же
+	// шкале; nil, если игру ещё ни разу не пересчитывали (см. NormalizeConfig)
+	NormalizedRating *float64
+	// WeightedRating - среднее с весами оценщиков (см. ReputationParams); nil, если весов нет
+	WeightedRating *float64
+	// VerifiedRating/VerifiedCount - среднее и число оценок проверенных игроков (владеют
+	// игрой и наиграли порог); VerifiedRating nil, если таких оценок нет
+	VerifiedRating *float64
+	VerifiedCount  int64
+	// Frozen - игра заморожена, значения взяты из снимка на момент заморозки (GameFreeze)
+	Frozen bool
+	// Scale - шкала, на которую спроецированы средние
+	Scale Scale
+}
+
+// Rating - оценка пользователя: исходное значение Value в шкале Scale
+// и нормализованный Score на канонической шкале.
+type Rating struct {
+	UserID string
+	GameID string
+	Value  int32
+	Scale  Scale
+	Score  float64
 }
 
 // RatingMessage — та же структура, что и в main_service
@@ -18,4 +39,30 @@ type RatingMessage struct {
 	GameID string `json:"game_id"`
 	UserID string `json:"user_id"`
 	Rating int32  `json:"rating"`
+	Scale  string `json:"scale,omitempty"`
+}
+
+// DistributionBucket - число оценок игры, чей score округляется до Score.
+type DistributionBucket struct {
+	Score int32
+	Count int64
+}
+
+type Distribution struct {
+	GameID  string
+	Total   int64
+	Buckets []DistributionBucket
+}
+
+// GameRatingUpdate - изменение агрегата игры; RatingsCount == 0 - оценок больше нет.
+type GameRatingUpdate struct {
+	GameRating
+	UpdatedAt time.Time
+}
+
+// RatingStream - подписка на изменения рейтингов. Next блокируется до следующей пачки;
+// ошибка ctx не завершает подписку, любая другая - завершает.
+type RatingStream interface {
+	Next(ctx context.Context) ([]GameRatingUpdate, error)
+	Close()
 }
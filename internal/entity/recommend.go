@@ -0,0 +1,53 @@
+package entity
+
+// RecommendationSource - откуда взялась рекомендация.
+type RecommendationSource string
+
+const (
+	// SourceItemCF - предсказание по похожим играм, которые пользователь уже оценил.
+	SourceItemCF RecommendationSource = "item_cf"
+	// SourcePopularity - популярная игра: у пользователя мало оценок или CF не набрал limit.
+	SourcePopularity RecommendationSource = "popularity"
+)
+
+// Recommendation - игра и предсказанная оценка на канонической шкале.
+// Support - для item_cf число оценённых пользователем соседей, для popularity - число оценок игры.
+type Recommendation struct {
+	GameID         string
+	PredictedScore float64
+	Source         RecommendationSource
+	Support        int64
+}
+
+// RecommendParams - MinNeighbors оценённых соседей нужно для CF-предсказания;
+// PopularityPrior - вес глобального среднего в сглаженном среднем популярных игр.
+type RecommendParams struct {
+	MinNeighbors    int
+	PopularityPrior float64
+}
+
+// EvalOptions - офлайн-оценка рекомендаций: у каждого пользователя хотя бы с двумя оценками
+// доля TestFraction откладывается; оценка >= RelevantScore считается попаданием для precision@K.
+type EvalOptions struct {
+	TestFraction  float64
+	K             int
+	RelevantScore float64
+	Seed          int64
+}
+
+// EvalResult - метрики item-based CF и базовой линии (только популярность) на одной выборке.
+type EvalResult struct {
+	TrainRatings int
+	TestRatings  int
+	// Users - пользователи, у которых в отложенной выборке есть релевантные игры
+	Users int
+	K     int
+
+	RMSE float64
+	// CFCoverage - доля отложенных оценок, которые CF смог предсказать; остальные - по популярности
+	CFCoverage   float64
+	PrecisionAtK float64
+
+	BaselineRMSE         float64
+	BaselinePrecisionAtK float64
+}
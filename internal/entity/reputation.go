@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// UserEventType - событие аккаунта из потока пользовательских событий.
+type UserEventType string
+
+const (
+	UserRegistered UserEventType = "registered"
+	// UserFlagged - аккаунт замечен в аномалиях (накрутка, бомбинг и т.п.)
+	UserFlagged   UserEventType = "flagged"
+	UserUnflagged UserEventType = "unflagged"
+)
+
+func ParseUserEventType(s string) (UserEventType, error) {
+	switch t := UserEventType(s); t {
+	case UserRegistered, UserFlagged, UserUnflagged:
+		return t, nil
+	default:
+		return "", fmt.Errorf("unknown user event type %q", s)
+	}
+}
+
+// UserEventMessage - сообщение топика пользовательских событий.
+type UserEventMessage struct {
+	UserID     string     `json:"user_id"`
+	Type       string     `json:"type"`
+	OccurredAt *time.Time `json:"occurred_at,omitempty"`
+	Reason     string     `json:"reason,omitempty"`
+}
+
+type UserEvent struct {
+	UserID     string
+	Type       UserEventType
+	OccurredAt time.Time
+	Reason     string
+}
+
+// ReputationParams - модель веса оценщика. Вес - среднее трёх факторов 0..1, не меньше
+// MinWeight, умноженное на штраф за пометки:
+//   - возраст аккаунта: линейно до 1 за AgeFull (без регистрации - от первой оценки);
+//   - история: log(1+n)/log(1+HistoryFull) оценок, не больше 1;
+//   - согласие с консенсусом: 1 - MAD/AgreementScale по играм хотя бы с MinConsensusRatings
+//     оценками, 0.5 - если таких игр нет.
+//
+// Помеченный сейчас пользователь получает FlaggedFactor, помеченный когда-то - PastFlagFactor.
+// Вес меняется (и игры пересчитываются), только если сдвинулся больше чем на ChangeThreshold.
+type ReputationParams struct {
+	AgeFull             time.Duration
+	HistoryFull         int
+	MinConsensusRatings int
+	AgreementScale      float64
+	MinWeight           float64
+	FlaggedFactor       float64
+	PastFlagFactor      float64
+	ChangeThreshold     float64
+}
+
+// ReweightStats - итог пересчёта весов.
+type ReweightStats struct {
+	Users int64
+	Games int64
+}
@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+)
+
+// Scale - шкала, в которой пришла оценка. Внутри сервиса все оценки
+// хранятся как score на канонической шкале ScoreMin..ScoreMax.
+type Scale string
+
+const (
+	ScaleStars5  Scale = "stars5"
+	ScaleTen     Scale = "ten"
+	ScaleHundred Scale = "hundred"
+	ScaleThumbs  Scale = "thumbs"
+)
+
+const (
+	ScoreMin = 1.0
+	ScoreMax = 10.0
+)
+
+type scaleRange struct {
+	min, max int32
+}
+
+var scaleRanges = map[Scale]scaleRange{
+	ScaleStars5:  {1, 5},
+	ScaleTen:     {1, 10},
+	ScaleHundred: {1, 100},
+	ScaleThumbs:  {0, 1},
+}
+
+// ParseScale - пустая строка означает шкалу по умолчанию def.
+func ParseScale(s string, def Scale) (Scale, error) {
+	if s == "" {
+		return def, nil
+	}
+	sc := Scale(s)
+	if _, ok := scaleRanges[sc]; !ok {
+		return "", fmt.Errorf("unknown rating scale %q", s)
+	}
+	return sc, nil
+}
+
+func (s Scale) Valid() bool {
+	_, ok := scaleRanges[s]
+	return ok
+}
+
+func (s Scale) Range() (min, max int32) {
+	r := scaleRanges[s]
+	return r.min, r.max
+}
+
+func (s Scale) Contains(value int32) bool {
+	r, ok := scaleRanges[s]
+	return ok && value >= r.min && value <= r.max
+}
+
+// Normalize переводит значение шкалы в канонический score (линейно, с точностью до сотых).
+func (s Scale) Normalize(value int32) float64 {
+	r := scaleRanges[s]
+	frac := float64(value-r.min) / float64(r.max-r.min)
+	return round2(ScoreMin + frac*(ScoreMax-ScoreMin))
+}
+
+// Project переводит канонический score (обычно среднее) обратно на шкалу s.
+// Для thumbs результат - доля положительных оценок от 0 до 1.
+func (s Scale) Project(score float64) float64 {
+	r := scaleRanges[s]
+	frac := (score - ScoreMin) / (ScoreMax - ScoreMin)
+	return round2(float64(r.min) + frac*float64(r.max-r.min))
+}
+
+// ClampScore возвращает в ScoreMin..ScoreMax оценку, вычисленную моделью (например, предсказанную).
+func ClampScore(score float64) float64 {
+	return min(max(score, ScoreMin), ScoreMax)
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
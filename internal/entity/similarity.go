@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// SimilarityMethod - как центрируются оценки перед косинусом между играми.
+type SimilarityMethod string
+
+const (
+	// SimilarityAdjustedCosine вычитает среднюю оценку пользователя: убирает разницу
+	// в том, кто как щедро ставит.
+	SimilarityAdjustedCosine SimilarityMethod = "adjusted_cosine"
+	// SimilarityPearson вычитает среднюю оценку игры.
+	SimilarityPearson SimilarityMethod = "pearson"
+)
+
+func ParseSimilarityMethod(s string) (SimilarityMethod, error) {
+	switch m := SimilarityMethod(s); m {
+	case SimilarityAdjustedCosine, SimilarityPearson:
+		return m, nil
+	default:
+		return "", fmt.Errorf("unknown similarity method %q", s)
+	}
+}
+
+// SimilarityParams - параметры расчёта: учитываются пары игр хотя бы с MinCoRaters
+// общими оценщиками, для каждой игры хранится TopK соседей с положительной похожестью.
+type SimilarityParams struct {
+	Method      SimilarityMethod
+	MinCoRaters int
+	TopK        int
+}
+
+type SimilarGame struct {
+	GameID     string
+	Similarity float64
+	CoRaters   int64
+	ComputedAt time.Time
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "fmt"
+
+// StudioRole - в каком качестве студия связана с игрой в каталоге.
+type StudioRole string
+
+const (
+	StudioDeveloper StudioRole = "developer"
+	StudioPublisher StudioRole = "publisher"
+)
+
+func ParseStudioRole(s string) (StudioRole, error) {
+	switch r := StudioRole(s); r {
+	case StudioDeveloper, StudioPublisher:
+		return r, nil
+	default:
+		return "", fmt.Errorf("unknown studio role %q", s)
+	}
+}
+
+// StudioRating - агрегат студии по её оценённым играм. AverageRating взвешен числом
+// оценок: это среднее по всем оценкам всех игр студии, а не среднее средних.
+type StudioRating struct {
+	Role          StudioRole
+	Name          string
+	GamesCount    int64
+	RatingsCount  int64
+	AverageRating float64
+	// Scale - шкала, на которую спроецировано среднее
+	Scale Scale
+}
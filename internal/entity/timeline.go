@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Granularity - шаг снимков рейтинга.
+type Granularity string
+
+const (
+	GranularityHour Granularity = "hour"
+	GranularityDay  Granularity = "day"
+)
+
+func ParseGranularity(s string) (Granularity, error) {
+	switch g := Granularity(s); g {
+	case "":
+		return GranularityDay, nil
+	case GranularityHour, GranularityDay:
+		return g, nil
+	default:
+		return "", fmt.Errorf("unknown granularity %q", s)
+	}
+}
+
+func (g Granularity) Step() time.Duration {
+	if g == GranularityHour {
+		return time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// Truncate - начало интервала, в который попадает t (в UTC).
+func (g Granularity) Truncate(t time.Time) time.Time {
+	return t.UTC().Truncate(g.Step())
+}
+
+// TimelinePoint - состояние рейтинга игры на конец интервала, начинающегося в At.
+// Distribution[i] - число оценок со score, округлённым до i+1.
+type TimelinePoint struct {
+	At            time.Time
+	RatingsCount  int64
+	AverageRating float64
+	Distribution  []int64
+}
+
+type Timeline struct {
+	GameID      string
+	Granularity Granularity
+	Scale       Scale
+	Points      []TimelinePoint
+}
@@ -0,0 +1,182 @@
+package metrics
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+const namespace = "rating_service"
+
+const (
+	SourceGRPC  = "grpc"
+	SourceKafka = "kafka"
+
+	OutcomeOK          = "ok"
+	OutcomeInvalid     = "invalid"
+	OutcomeRateLimited = "rate_limited"
+	OutcomeError       = "error"
+)
+
+type Metrics struct {
+	RatingsSubmitted   *prometheus.CounterVec
+	RatingsWritten     *prometheus.CounterVec
+	ScoreDistribution  prometheus.Histogram
+	RepoQueryDuration  *prometheus.HistogramVec
+	TxRetries          *prometheus.CounterVec
+	ConsumerLag        *prometheus.GaugeVec
+	ConsumerProcessing prometheus.Histogram
+	ConsumerErrors     *prometheus.CounterVec
+	GRPCRequests       *prometheus.CounterVec
+	GRPCDuration       *prometheus.HistogramVec
+	WatchSubscribers   prometheus.Gauge
+	WatchUpdates       *prometheus.CounterVec
+	WatchReconnects    prometheus.Counter
+}
+
+// New создаёт метрики сервиса и регистрирует их в reg.
+func New(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		RatingsSubmitted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "ratings_submitted_total",
+			Help:      "Rating submissions by source and outcome.",
+		}, []string{"source", "outcome"}),
+		RatingsWritten: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "ratings_written_total",
+			Help:      "Ratings stored, split into new and updated ones.",
+		}, []string{"kind"}),
+		ScoreDistribution: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "rating_score",
+			Help:      "Distribution of submitted normalized scores.",
+			Buckets:   prometheus.LinearBuckets(entity.ScoreMin, 1, int(entity.ScoreMax-entity.ScoreMin)+1),
+		}),
+		RepoQueryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "repository",
+			Name:      "query_duration_seconds",
+			Help:      "Repository method latency.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "status"}),
+		TxRetries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "repository",
+			Name:      "tx_retries_total",
+			Help:      "Transactions retried after serialization failures or deadlocks.",
+		}, []string{"method"}),
+		ConsumerLag: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "kafka_consumer",
+			Name:      "lag",
+			Help:      "Messages behind the partition high watermark.",
+		}, []string{"topic", "partition"}),
+		ConsumerProcessing: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "kafka_consumer",
+			Name:      "processing_duration_seconds",
+			Help:      "Time spent handling one message.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		ConsumerErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "kafka_consumer",
+			Name:      "errors_total",
+			Help:      "Consumer errors by stage.",
+		}, []string{"stage"}),
+		GRPCRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "gRPC requests by method and status code.",
+		}, []string{"method", "code"}),
+		GRPCDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "gRPC request latency.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		WatchSubscribers: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "watch",
+			Name:      "subscribers",
+			Help:      "Active rating update subscriptions.",
+		}),
+		WatchUpdates: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "watch",
+			Name:      "updates_total",
+			Help:      "Rating updates per subscriber: queued, coalesced with a pending one, or dropped with a slow subscriber.",
+		}, []string{"result"}),
+		WatchReconnects: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "watch",
+			Name:      "listener_reconnects_total",
+			Help:      "LISTEN connection restarts.",
+		}),
+	}
+
+	reg.MustRegister(
+		m.RatingsSubmitted,
+		m.RatingsWritten,
+		m.ScoreDistribution,
+		m.RepoQueryDuration,
+		m.TxRetries,
+		m.ConsumerLag,
+		m.ConsumerProcessing,
+		m.ConsumerErrors,
+		m.GRPCRequests,
+		m.GRPCDuration,
+		m.WatchSubscribers,
+		m.WatchUpdates,
+		m.WatchReconnects,
+	)
+
+	return m
+}
+
+// ObserveQuery - вызывать через defer с указателем на возвращаемую ошибку.
+func (m *Metrics) ObserveQuery(method string, start time.Time, err *error) {
+	status := "ok"
+	if err != nil && *err != nil && !errors.Is(*err, entity.ErrGameNotFound) {
+		status = "error"
+	}
+	m.RepoQueryDuration.WithLabelValues(method, status).Observe(time.Since(start).Seconds())
+}
+
+func (m *Metrics) ObserveWrite(isNew bool, score float64) {
+	kind := "updated"
+	if isNew {
+		kind = "new"
+	}
+	m.RatingsWritten.WithLabelValues(kind).Inc()
+	m.ScoreDistribution.Observe(score)
+}
+
+func (m *Metrics) ObserveSubmit(source string, code codes.Code) {
+	m.RatingsSubmitted.WithLabelValues(source, Outcome(code)).Inc()
+}
+
+func (m *Metrics) SetLag(topic string, partition int, lag int64) {
+	m.ConsumerLag.WithLabelValues(topic, strconv.Itoa(partition)).Set(float64(lag))
+}
+
+// Outcome сворачивает код ответа в метку outcome.
+func Outcome(code codes.Code) string {
+	switch code {
+	case codes.OK:
+		return OutcomeOK
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return OutcomeInvalid
+	case codes.ResourceExhausted:
+		return OutcomeRateLimited
+	default:
+		return OutcomeError
+	}
+}
@@ -0,0 +1,153 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"go.uber.org/zap"
+)
+
+func TestRefill(t *testing.T) {
+	last := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		tokens  float64
+		elapsed time.Duration
+		rule    config.RateLimitRule
+		want    float64
+	}{
+		{"empty bucket refills at rate", 0, 500 * time.Millisecond, config.RateLimitRule{Rate: 2, Burst: 5}, 1},
+		{"partial token accumulates", 0.25, 250 * time.Millisecond, config.RateLimitRule{Rate: 1, Burst: 5}, 0.5},
+		{"capped at burst", 1, time.Hour, config.RateLimitRule{Rate: 10, Burst: 3}, 3},
+		{"zero burst holds one token", 0, time.Hour, config.RateLimitRule{Rate: 1, Burst: 0}, 1},
+		{"no time elapsed", 2, 0, config.RateLimitRule{Rate: 1, Burst: 5}, 2},
+		{"clock went backwards", 2, -time.Second, config.RateLimitRule{Rate: 1, Burst: 5}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := refill(tt.tokens, last, last.Add(tt.elapsed), tt.rule); got != tt.want {
+				t.Errorf("refill() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWait(t *testing.T) {
+	tests := []struct {
+		name   string
+		tokens float64
+		rule   config.RateLimitRule
+		want   time.Duration
+	}{
+		{"token available", 1, config.RateLimitRule{Rate: 1, Burst: 1}, 0},
+		{"empty bucket", 0, config.RateLimitRule{Rate: 2, Burst: 1}, 500 * time.Millisecond},
+		{"half token", 0.5, config.RateLimitRule{Rate: 1, Burst: 1}, 500 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wait(tt.tokens, tt.rule); got != tt.want {
+				t.Errorf("wait() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMemoryStoreTake проходит по одному bucket'у: каждый шаг зависит от предыдущих.
+func TestMemoryStoreTake(t *testing.T) {
+	rule := config.RateLimitRule{Rate: 1, Burst: 3}
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	steps := []struct {
+		at         time.Duration
+		wantDenied int
+		wantRetry  time.Duration
+	}{
+		{0, -1, 0},
+		{0, -1, 0},
+		{0, -1, 0},
+		{0, 0, time.Second},
+		{500 * time.Millisecond, 0, 500 * time.Millisecond},
+		{time.Second, -1, 0},
+		{time.Second, 0, time.Second},
+	}
+
+	store := NewMemoryStore()
+	buckets := []Bucket{{Key: "rl:user:u1", Rule: rule}}
+	for i, st := range steps {
+		denied, retry, err := store.Take(context.Background(), buckets, start.Add(st.at))
+		if err != nil {
+			t.Fatalf("step %d: Take() error = %v", i, err)
+		}
+		if denied != st.wantDenied || retry != st.wantRetry {
+			t.Errorf("step %d: Take() = (%d, %v), want (%d, %v)", i, denied, retry, st.wantDenied, st.wantRetry)
+		}
+	}
+}
+
+func TestMemoryStoreTakeAllOrNothing(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	shared := Bucket{Key: "rl:global:global", Rule: config.RateLimitRule{Rate: 1, Burst: 2}}
+	user := Bucket{Key: "rl:user:u1", Rule: config.RateLimitRule{Rate: 1, Burst: 1}}
+
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	if denied, _, _ := store.Take(ctx, []Bucket{shared, user}, now); denied != -1 {
+		t.Fatalf("first Take() denied = %d, want -1", denied)
+	}
+	if denied, _, _ := store.Take(ctx, []Bucket{shared, user}, now); denied != 1 {
+		t.Fatalf("second Take() denied = %d, want 1 (user bucket)", denied)
+	}
+	// отказ по bucket'у пользователя не должен был забрать токен из общего
+	if denied, _, _ := store.Take(ctx, []Bucket{shared}, now); denied != -1 {
+		t.Errorf("shared bucket Take() denied = %d, want -1", denied)
+	}
+}
+
+func TestLimiterAllow(t *testing.T) {
+	cfg := config.RateLimitConfig{
+		Global:    config.RateLimitRule{Rate: 0.001, Burst: 3},
+		PerClient: config.RateLimitRule{Rate: 0.001, Burst: 10},
+		PerUser:   config.RateLimitRule{Rate: 0.001, Burst: 1},
+	}
+
+	tests := []struct {
+		name string
+		cfg  config.RateLimitConfig
+		// before - пользователи, чьи запросы пришли до проверяемого
+		before    []string
+		userID    string
+		wantAllow bool
+		wantScope Scope
+	}{
+		{"first request", cfg, nil, "u1", true, ""},
+		{"user over its limit", cfg, []string{"u1"}, "u1", false, ScopeUser},
+		{"global over its limit", cfg, []string{"u1", "u2", "u3"}, "u4", false, ScopeGlobal},
+		{"user denial keeps global tokens", cfg, []string{"u1", "u1", "u1", "u2"}, "u3", true, ""},
+		{"no user id skips user limit", cfg, []string{""}, "", true, ""},
+		{"zero rate disables limit", config.RateLimitConfig{}, []string{"u1", "u1"}, "u1", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := NewWithStore(tt.cfg, NewMemoryStore(), zap.NewNop())
+			ctx := context.Background()
+			for _, u := range tt.before {
+				limiter.Allow(ctx, u, "client")
+			}
+
+			res := limiter.Allow(ctx, tt.userID, "client")
+			if res.Allowed != tt.wantAllow || res.Scope != tt.wantScope {
+				t.Errorf("Allow() = %+v, want allowed %v scope %q", res, tt.wantAllow, tt.wantScope)
+			}
+			if !res.Allowed && res.RetryAfter <= 0 {
+				t.Errorf("Allow() RetryAfter = %v, want > 0", res.RetryAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+)
+
+const _sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	rule   config.RateLimitRule
+}
+
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*bucket), lastSweep: time.Now()}
+}
+
+func (s *MemoryStore) Take(_ context.Context, buckets []Bucket, now time.Time) (int, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	tokens := make([]float64, len(buckets))
+	denied := -1
+	var retryAfter time.Duration
+	for i, bk := range buckets {
+		tokens[i] = burst(bk.Rule)
+		if b, ok := s.buckets[bk.Key]; ok {
+			tokens[i] = refill(b.tokens, b.last, now, bk.Rule)
+		}
+		if tokens[i] < 1 {
+			if denied < 0 {
+				denied = i
+			}
+			retryAfter = max(retryAfter, wait(tokens[i], bk.Rule))
+		}
+	}
+	if denied >= 0 {
+		return denied, retryAfter, nil
+	}
+
+	for i, bk := range buckets {
+		s.buckets[bk.Key] = &bucket{tokens: tokens[i] - 1, last: now, rule: bk.Rule}
+	}
+
+	return -1, 0, nil
+}
+
+// sweep удаляет bucket'ы, которые успели заполниться целиком - они ничем не отличаются от новых.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < _sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		refill := time.Duration(float64(b.rule.Burst) / b.rule.Rate * float64(time.Second))
+		if now.Sub(b.last) >= refill {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func (s *MemoryStore) Close() error {
+	return nil
+}
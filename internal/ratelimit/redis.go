@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Тот же алгоритм, что и MemoryStore.Take, но атомарно на стороне Redis (или совместимого
+// сервера) для всех bucket'ов сразу: KEYS - bucket'ы, ARGV - now и пары rate, burst.
+// Ключи разных bucket'ов должны жить на одном сервере (не Redis Cluster).
+var takeScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local tokens = {}
+local denied = -1
+local wait = 0
+
+for i, key in ipairs(KEYS) do
+  local rate = tonumber(ARGV[2 * i])
+  local burst = math.max(tonumber(ARGV[2 * i + 1]), 1)
+  local state = redis.call('HMGET', key, 'tokens', 'ts')
+  local t = tonumber(state[1]) or burst
+  local ts = tonumber(state[2]) or now
+  t = math.min(burst, t + math.max(0, now - ts) / 1000 * rate)
+  tokens[i] = t
+  if t < 1 then
+    if denied < 0 then
+      denied = i - 1
+    end
+    wait = math.max(wait, math.ceil((1 - t) / rate * 1000))
+  end
+end
+
+if denied >= 0 then
+  return {denied, wait}
+end
+
+for i, key in ipairs(KEYS) do
+  local rate = tonumber(ARGV[2 * i])
+  local burst = math.max(tonumber(ARGV[2 * i + 1]), 1)
+  redis.call('HSET', key, 'tokens', tostring(tokens[i] - 1), 'ts', now)
+  redis.call('PEXPIRE', key, math.ceil(burst / rate * 1000) + 1000)
+end
+
+return {-1, 0}
+`)
+
+type RedisStore struct {
+	client *redis.Client
+}
+
+func NewRedisStore(addr, password string, db int) *RedisStore {
+	return &RedisStore{client: redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: password,
+		DB:       db,
+	})}
+}
+
+func (s *RedisStore) Take(ctx context.Context, buckets []Bucket, now time.Time) (int, time.Duration, error) {
+	keys := make([]string, 0, len(buckets))
+	args := []any{now.UnixMilli()}
+	for _, b := range buckets {
+		keys = append(keys, b.Key)
+		args = append(args, strconv.FormatFloat(b.Rule.Rate, 'f', -1, 64), b.Rule.Burst)
+	}
+
+	res, err := takeScript.Run(ctx, s.client, keys, args...).Int64Slice()
+	if err != nil {
+		return -1, 0, fmt.Errorf("ratelimit - RedisStore.Take: %w", err)
+	}
+	if len(res) != 2 {
+		return -1, 0, fmt.Errorf("ratelimit - RedisStore.Take: unexpected reply %v", res)
+	}
+
+	return int(res[0]), time.Duration(res[1]) * time.Millisecond, nil
+}
+
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,106 @@
+package recommend
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// Evaluate откладывает у каждого пользователя долю opts.TestFraction оценок (хотя бы одна
+// остаётся в обучении), обучает модель на остальных и считает на отложенных RMSE и precision@K.
+// Те же метрики считаются для базовой линии - только популярность.
+func Evaluate(ratings []Rating, sim entity.SimilarityParams, params entity.RecommendParams,
+	opts entity.EvalOptions) entity.EvalResult {
+
+	train, test := split(ratings, opts.TestFraction, opts.Seed)
+	model := Train(train, sim, params)
+
+	res := entity.EvalResult{TrainRatings: len(train), TestRatings: len(test), K: opts.K}
+
+	byUser := make(map[string]map[string]float64)
+	for _, r := range train {
+		if byUser[r.UserID] == nil {
+			byUser[r.UserID] = make(map[string]float64)
+		}
+		byUser[r.UserID][r.GameID] = r.Score
+	}
+
+	var sqErr, baseSqErr float64
+	var covered int
+	relevant := make(map[string]map[string]struct{})
+	for _, r := range test {
+		base := model.PopularityScore(r.GameID)
+		pred, ok := model.Predict(byUser[r.UserID], r.GameID)
+		if ok {
+			covered++
+		} else {
+			pred = base
+		}
+		sqErr += (pred - r.Score) * (pred - r.Score)
+		baseSqErr += (base - r.Score) * (base - r.Score)
+
+		if r.Score >= opts.RelevantScore {
+			if relevant[r.UserID] == nil {
+				relevant[r.UserID] = make(map[string]struct{})
+			}
+			relevant[r.UserID][r.GameID] = struct{}{}
+		}
+	}
+	if len(test) > 0 {
+		res.RMSE = math.Sqrt(sqErr / float64(len(test)))
+		res.BaselineRMSE = math.Sqrt(baseSqErr / float64(len(test)))
+		res.CFCoverage = float64(covered) / float64(len(test))
+	}
+
+	var precision, basePrecision float64
+	for user, games := range relevant {
+		precision += hits(model.Recommend(byUser[user], opts.K, false), games)
+		basePrecision += hits(model.Recommend(byUser[user], opts.K, true), games)
+	}
+	if res.Users = len(relevant); res.Users > 0 && opts.K > 0 {
+		res.PrecisionAtK = precision / float64(res.Users*opts.K)
+		res.BaselinePrecisionAtK = basePrecision / float64(res.Users*opts.K)
+	}
+
+	return res
+}
+
+func hits(recs []entity.Recommendation, relevant map[string]struct{}) float64 {
+	var n float64
+	for _, r := range recs {
+		if _, ok := relevant[r.GameID]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// split - детерминированное при одном seed разбиение по пользователям.
+func split(ratings []Rating, fraction float64, seed int64) (train, test []Rating) {
+	rnd := rand.New(rand.NewSource(seed))
+
+	byUser := make(map[string][]Rating)
+	var users []string
+	for _, r := range ratings {
+		if _, ok := byUser[r.UserID]; !ok {
+			users = append(users, r.UserID)
+		}
+		byUser[r.UserID] = append(byUser[r.UserID], r)
+	}
+
+	for _, u := range users {
+		rs := byUser[u]
+		n := int(math.Round(float64(len(rs)) * fraction))
+		n = min(n, len(rs)-1)
+		if n <= 0 {
+			train = append(train, rs...)
+			continue
+		}
+		rnd.Shuffle(len(rs), func(i, j int) { rs[i], rs[j] = rs[j], rs[i] })
+		test = append(test, rs[:n]...)
+		train = append(train, rs[n:]...)
+	}
+
+	return train, test
+}
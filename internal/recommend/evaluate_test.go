@@ -0,0 +1,138 @@
+package recommend
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+var testSim = entity.SimilarityParams{Method: entity.SimilarityAdjustedCosine, MinCoRaters: 2, TopK: 5}
+
+// twoTastes - g1 и g2 нравятся одним и тем же пользователям, g3 - остальным: adjusted
+// cosine g1-g2 равен 1, пары с g3 отрицательные и в соседи не попадают.
+func twoTastes() []Rating {
+	return []Rating{
+		{"u1", "g1", 9}, {"u1", "g2", 9}, {"u1", "g3", 3},
+		{"u2", "g1", 4}, {"u2", "g2", 4}, {"u2", "g3", 10},
+	}
+}
+
+func TestPredict(t *testing.T) {
+	tests := []struct {
+		name         string
+		minNeighbors int
+		user         map[string]float64
+		gameID       string
+		wantScore    float64
+		wantOK       bool
+	}{
+		// mu = 4, g1 отклоняется на +2 при похожести 1
+		{"one similar neighbour", 1, map[string]float64{"g1": 6, "g3": 2}, "g2", 6, true},
+		{"not enough neighbours", 2, map[string]float64{"g1": 6, "g3": 2}, "g2", 0, false},
+		{"no similar games rated", 1, map[string]float64{"g3": 7}, "g2", 0, false},
+	}
+
+	model := Train(twoTastes(), testSim, entity.RecommendParams{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model.params.MinNeighbors = tt.minNeighbors
+			score, ok := model.Predict(tt.user, tt.gameID)
+			if ok != tt.wantOK || math.Abs(score-tt.wantScore) > 1e-9 {
+				t.Errorf("Predict() = (%v, %v), want (%v, %v)", score, ok, tt.wantScore, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestPopularityScore(t *testing.T) {
+	ratings := []Rating{{"u1", "g1", 10}, {"u2", "g1", 8}, {"u1", "g2", 4}}
+	// среднее по всем 22/3; prior 1 тянет средние игр к нему
+	tests := []struct {
+		gameID string
+		want   float64
+	}{
+		{"g1", (18 + 22.0/3) / 3},
+		{"g2", (4 + 22.0/3) / 2},
+		{"unknown", 22.0 / 3},
+	}
+
+	model := Train(ratings, testSim, entity.RecommendParams{PopularityPrior: 1})
+	for _, tt := range tests {
+		t.Run(tt.gameID, func(t *testing.T) {
+			if got := model.PopularityScore(tt.gameID); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("PopularityScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	ratings := func(perUser ...int) []Rating {
+		var out []Rating
+		for u, n := range perUser {
+			for g := range n {
+				out = append(out, Rating{fmt.Sprintf("u%d", u), fmt.Sprintf("g%d", g), 5})
+			}
+		}
+		return out
+	}
+
+	tests := []struct {
+		name      string
+		ratings   []Rating
+		fraction  float64
+		wantTest  int
+		wantTrain int
+	}{
+		{"half of each user", ratings(4, 2), 0.5, 3, 3},
+		{"single rating stays in train", ratings(1, 1), 0.5, 0, 2},
+		{"at least one rating in train", ratings(3), 1, 2, 1},
+		{"nothing held out", ratings(4), 0, 0, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			train, test := split(tt.ratings, tt.fraction, 1)
+			if len(test) != tt.wantTest || len(train) != tt.wantTrain {
+				t.Errorf("split() = %d train, %d test, want %d, %d", len(train), len(test), tt.wantTrain, tt.wantTest)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	var ratings []Rating
+	for u := range 20 {
+		for g := range 6 {
+			score := 3.0
+			if (u%2 == 0) == (g < 3) {
+				score = 9
+			}
+			ratings = append(ratings, Rating{fmt.Sprintf("u%d", u), fmt.Sprintf("g%d", g), score})
+		}
+	}
+	params := entity.RecommendParams{MinNeighbors: 1, PopularityPrior: 1}
+	opts := entity.EvalOptions{TestFraction: 0.34, K: 2, RelevantScore: 8, Seed: 7}
+
+	res := Evaluate(ratings, testSim, params, opts)
+
+	if res.TrainRatings+res.TestRatings != len(ratings) || res.TestRatings != 40 {
+		t.Errorf("Evaluate() split %d/%d, want 80/40", res.TrainRatings, res.TestRatings)
+	}
+	if res.CFCoverage < 0 || res.CFCoverage > 1 {
+		t.Errorf("Evaluate() CFCoverage = %v, want within [0, 1]", res.CFCoverage)
+	}
+	// у двух групп с противоположными вкусами CF должен обходить среднее по игре
+	if res.RMSE >= res.BaselineRMSE {
+		t.Errorf("Evaluate() RMSE = %v, want below baseline %v", res.RMSE, res.BaselineRMSE)
+	}
+	if res.PrecisionAtK < res.BaselinePrecisionAtK {
+		t.Errorf("Evaluate() precision@K = %v, want at least baseline %v", res.PrecisionAtK, res.BaselinePrecisionAtK)
+	}
+	if again := Evaluate(ratings, testSim, params, opts); !reflect.DeepEqual(again, res) {
+		t.Errorf("Evaluate() with the same seed = %+v, want %+v", again, res)
+	}
+}
@@ -0,0 +1,266 @@
+// Package recommend - item-based CF в памяти. Повторяет то, что в рабочем режиме считает
+// Postgres (game_similarities, RecommendCFRepo, PopularGamesRepo), и нужен для офлайн-оценки
+// на отложенной выборке, которую в базу не положить.
+package recommend
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+type Rating struct {
+	UserID string
+	GameID string
+	Score  float64
+}
+
+type neighbour struct {
+	gameID     string
+	similarity float64
+}
+
+type Model struct {
+	params entity.RecommendParams
+	// neighbours[i] - top-K соседей игры i
+	neighbours map[string][]neighbour
+	// popular - игры по убыванию сглаженного среднего
+	popular     []entity.Recommendation
+	popularByID map[string]float64
+	globalMean  float64
+}
+
+// Train строит соседей и популярность по обучающим оценкам.
+func Train(ratings []Rating, sim entity.SimilarityParams, params entity.RecommendParams) *Model {
+	m := &Model{
+		params:      params,
+		neighbours:  make(map[string][]neighbour),
+		popularByID: make(map[string]float64),
+	}
+	m.trainPopularity(ratings)
+	m.trainSimilarity(ratings, sim)
+	return m
+}
+
+func (m *Model) trainPopularity(ratings []Rating) {
+	type agg struct {
+		sum   float64
+		count int64
+	}
+	games := make(map[string]*agg)
+	var total float64
+	for _, r := range ratings {
+		a := games[r.GameID]
+		if a == nil {
+			a = &agg{}
+			games[r.GameID] = a
+		}
+		a.sum += r.Score
+		a.count++
+		total += r.Score
+	}
+	if len(ratings) > 0 {
+		m.globalMean = total / float64(len(ratings))
+	}
+
+	prior := m.params.PopularityPrior
+	for id, a := range games {
+		score := (a.sum + prior*m.globalMean) / (float64(a.count) + prior)
+		m.popular = append(m.popular, entity.Recommendation{
+			GameID: id, PredictedScore: score, Source: entity.SourcePopularity, Support: a.count,
+		})
+		m.popularByID[id] = score
+	}
+	slices.SortFunc(m.popular, func(a, b entity.Recommendation) int {
+		return cmp.Or(cmp.Compare(b.PredictedScore, a.PredictedScore), cmp.Compare(b.Support, a.Support),
+			cmp.Compare(a.GameID, b.GameID))
+	})
+}
+
+// trainSimilarity - как similarityPairsSQL: отклонения от средней пользователя или игры,
+// косинус по общим оценщикам, только положительная похожесть, top-K на игру.
+func (m *Model) trainSimilarity(ratings []Rating, sim entity.SimilarityParams) {
+	type pairKey struct{ a, b string }
+	type pairAgg struct {
+		num, sqA, sqB float64
+		co            int
+	}
+
+	center := func(r Rating) string {
+		if sim.Method == entity.SimilarityPearson {
+			return r.GameID
+		}
+		return r.UserID
+	}
+	means := meanBy(ratings, center)
+
+	type dev struct {
+		gameID string
+		dev    float64
+	}
+	byUser := make(map[string][]dev)
+	for _, r := range ratings {
+		byUser[r.UserID] = append(byUser[r.UserID], dev{r.GameID, r.Score - means[center(r)]})
+	}
+
+	pairs := make(map[pairKey]*pairAgg)
+	for _, devs := range byUser {
+		for i := range devs {
+			for j := i + 1; j < len(devs); j++ {
+				a, b := devs[i], devs[j]
+				if a.gameID > b.gameID {
+					a, b = b, a
+				}
+				p := pairs[pairKey{a.gameID, b.gameID}]
+				if p == nil {
+					p = &pairAgg{}
+					pairs[pairKey{a.gameID, b.gameID}] = p
+				}
+				p.num += a.dev * b.dev
+				p.sqA += a.dev * a.dev
+				p.sqB += b.dev * b.dev
+				p.co++
+			}
+		}
+	}
+
+	for k, p := range pairs {
+		if p.co < sim.MinCoRaters || p.sqA == 0 || p.sqB == 0 {
+			continue
+		}
+		s := p.num / math.Sqrt(p.sqA*p.sqB)
+		if s <= 0 {
+			continue
+		}
+		m.neighbours[k.a] = append(m.neighbours[k.a], neighbour{k.b, s})
+		m.neighbours[k.b] = append(m.neighbours[k.b], neighbour{k.a, s})
+	}
+
+	for id, ns := range m.neighbours {
+		slices.SortFunc(ns, func(a, b neighbour) int {
+			return cmp.Or(cmp.Compare(b.similarity, a.similarity), cmp.Compare(a.gameID, b.gameID))
+		})
+		if len(ns) > sim.TopK {
+			m.neighbours[id] = ns[:sim.TopK]
+		}
+	}
+}
+
+// Predict - оценка пользователя с оценками user для игры gameID; ok == false, если
+// у игры меньше MinNeighbors оценённых пользователем соседей.
+func (m *Model) Predict(user map[string]float64, gameID string) (score float64, ok bool) {
+	recs := m.predictAll(user, func(id string) bool { return id == gameID })
+	if len(recs) == 0 {
+		return 0, false
+	}
+	return recs[0].PredictedScore, true
+}
+
+// PopularityScore - сглаженное среднее игры; для незнакомой игры - среднее по всем.
+func (m *Model) PopularityScore(gameID string) float64 {
+	if s, ok := m.popularByID[gameID]; ok {
+		return s
+	}
+	return m.globalMean
+}
+
+// Recommend - как usecase.RecommendGames: CF, а недостающее - популярными играми.
+// popularOnly - базовая линия без CF.
+func (m *Model) Recommend(user map[string]float64, limit int, popularOnly bool) []entity.Recommendation {
+	var recs []entity.Recommendation
+	if !popularOnly {
+		recs = m.predictAll(user, func(id string) bool {
+			_, rated := user[id]
+			return !rated
+		})
+		slices.SortFunc(recs, func(a, b entity.Recommendation) int {
+			return cmp.Or(cmp.Compare(b.PredictedScore, a.PredictedScore), cmp.Compare(b.Support, a.Support),
+				cmp.Compare(a.GameID, b.GameID))
+		})
+		if len(recs) > limit {
+			recs = recs[:limit]
+		}
+	}
+
+	chosen := make(map[string]struct{}, len(recs))
+	for _, r := range recs {
+		chosen[r.GameID] = struct{}{}
+	}
+	for _, p := range m.popular {
+		if len(recs) >= limit {
+			break
+		}
+		if _, rated := user[p.GameID]; rated {
+			continue
+		}
+		if _, ok := chosen[p.GameID]; ok {
+			continue
+		}
+		recs = append(recs, p)
+	}
+
+	return recs
+}
+
+// predictAll - предсказания для соседей оценённых игр, прошедших фильтр want.
+func (m *Model) predictAll(user map[string]float64, want func(gameID string) bool) []entity.Recommendation {
+	if len(user) == 0 {
+		return nil
+	}
+	var mu float64
+	for _, s := range user {
+		mu += s
+	}
+	mu /= float64(len(user))
+
+	type acc struct {
+		num, den float64
+		support  int64
+	}
+	cand := make(map[string]*acc)
+	for rated, score := range user {
+		for _, n := range m.neighbours[rated] {
+			if !want(n.gameID) {
+				continue
+			}
+			a := cand[n.gameID]
+			if a == nil {
+				a = &acc{}
+				cand[n.gameID] = a
+			}
+			a.num += n.similarity * (score - mu)
+			a.den += n.similarity
+			a.support++
+		}
+	}
+
+	out := make([]entity.Recommendation, 0, len(cand))
+	for id, a := range cand {
+		if a.support < int64(m.params.MinNeighbors) {
+			continue
+		}
+		out = append(out, entity.Recommendation{
+			GameID:         id,
+			PredictedScore: entity.ClampScore(mu + a.num/a.den),
+			Source:         entity.SourceItemCF,
+			Support:        a.support,
+		})
+	}
+	return out
+}
+
+func meanBy(ratings []Rating, key func(Rating) string) map[string]float64 {
+	sums := make(map[string]float64)
+	counts := make(map[string]int)
+	for _, r := range ratings {
+		k := key(r)
+		sums[k] += r.Score
+		counts[k]++
+	}
+	for k := range sums {
+		sums[k] /= float64(counts[k])
+	}
+	return sums
+}
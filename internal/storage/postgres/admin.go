@@ -0,0 +1,216 @@
+package postgres_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// recomputeGameSQL пересчитывает агрегат игры (обычный, взвешенный и по проверенным
+// игрокам) по таблице ratings;
+// если оценок не осталось - удаляет строку из game_ratings.
+const recomputeGameSQL = `
+    WITH agg AS (
+      SELECT COUNT(*) AS cnt, COALESCE(SUM(score), 0) AS total,
+             ROUND(COALESCE(SUM(rater_weight(user_id) * score), 0), 4) AS wsum,
+             ROUND(COALESCE(SUM(rater_weight(user_id)), 0), 4) AS wtotal,
+             COUNT(*) FILTER (WHERE p.verified) AS vcnt,
+             COALESCE(SUM(score) FILTER (WHERE p.verified), 0) AS vsum
+      FROM ratings r
+      LEFT JOIN player_games p USING (user_id, game_id)
+      WHERE r.game_id = $1
+    ), del AS (
+      DELETE FROM game_ratings
+      WHERE game_id = $1 AND (SELECT cnt FROM agg) = 0
+    )
+    INSERT INTO game_ratings(game_id, ratings_count, ratings_sum, average_rating,
+                             weighted_sum, weight_total, weighted_average,
+                             verified_count, verified_sum, verified_average)
+    SELECT $1, cnt, total, ROUND(total / cnt, 2), wsum, wtotal, ROUND(wsum / NULLIF(wtotal, 0), 2),
+           vcnt, vsum, ROUND(vsum / NULLIF(vcnt, 0), 2)
+    FROM agg
+    WHERE cnt > 0
+    ON CONFLICT (game_id) DO UPDATE
+      SET ratings_count    = EXCLUDED.ratings_count,
+          ratings_sum      = EXCLUDED.ratings_sum,
+          average_rating   = EXCLUDED.average_rating,
+          weighted_sum     = EXCLUDED.weighted_sum,
+          weight_total     = EXCLUDED.weight_total,
+          weighted_average = EXCLUDED.weighted_average,
+          verified_count   = EXCLUDED.verified_count,
+          verified_sum     = EXCLUDED.verified_sum,
+          verified_average = EXCLUDED.verified_average
+`
+
+func recomputeGame(ctx context.Context, tx pgx.Tx, gameID string) error {
+	_, err := tx.Exec(ctx, recomputeGameSQL, gameID)
+	return err
+}
+
+// ReconcileAggregatesRepo пересобирает game_ratings из ratings и возвращает число исправленных игр.
+func (r *RatingRepository) ReconcileAggregatesRepo(ctx context.Context) (_ int64, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ReconcileAggregatesRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ReconcileAggregatesRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ReconcileAggregatesRepo"))
+
+	var fixed int64
+	err = r.pg.Pool.QueryRow(ctx, `
+      WITH agg AS (
+        SELECT game_id, COUNT(*) AS cnt, SUM(score) AS total,
+               ROUND(SUM(rater_weight(user_id) * score), 4) AS wsum,
+               ROUND(SUM(rater_weight(user_id)), 4) AS wtotal,
+               COUNT(*) FILTER (WHERE p.verified) AS vcnt,
+               COALESCE(SUM(score) FILTER (WHERE p.verified), 0) AS vsum
+        FROM ratings r
+        LEFT JOIN player_games p USING (user_id, game_id)
+        GROUP BY game_id
+      ), upd AS (
+        INSERT INTO game_ratings(game_id, ratings_count, ratings_sum, average_rating,
+                                 weighted_sum, weight_total, weighted_average,
+                                 verified_count, verified_sum, verified_average)
+        SELECT game_id, cnt, total, ROUND(total / cnt, 2), wsum, wtotal, ROUND(wsum / NULLIF(wtotal, 0), 2),
+               vcnt, vsum, ROUND(vsum / NULLIF(vcnt, 0), 2)
+        FROM agg
+        ON CONFLICT (game_id) DO UPDATE
+          SET ratings_count    = EXCLUDED.ratings_count,
+              ratings_sum      = EXCLUDED.ratings_sum,
+              average_rating   = EXCLUDED.average_rating,
+              weighted_sum     = EXCLUDED.weighted_sum,
+              weight_total     = EXCLUDED.weight_total,
+              weighted_average = EXCLUDED.weighted_average,
+              verified_count   = EXCLUDED.verified_count,
+              verified_sum     = EXCLUDED.verified_sum,
+              verified_average = EXCLUDED.verified_average
+          WHERE game_ratings.ratings_count    IS DISTINCT FROM EXCLUDED.ratings_count
+             OR game_ratings.ratings_sum      IS DISTINCT FROM EXCLUDED.ratings_sum
+             OR game_ratings.average_rating   IS DISTINCT FROM EXCLUDED.average_rating
+             OR game_ratings.weighted_sum     IS DISTINCT FROM EXCLUDED.weighted_sum
+             OR game_ratings.weight_total     IS DISTINCT FROM EXCLUDED.weight_total
+             OR game_ratings.weighted_average IS DISTINCT FROM EXCLUDED.weighted_average
+             OR game_ratings.verified_count   IS DISTINCT FROM EXCLUDED.verified_count
+             OR game_ratings.verified_sum     IS DISTINCT FROM EXCLUDED.verified_sum
+             OR game_ratings.verified_average IS DISTINCT FROM EXCLUDED.verified_average
+        RETURNING 1
+      ), del AS (
+        DELETE FROM game_ratings g
+        WHERE NOT EXISTS (SELECT 1 FROM ratings r WHERE r.game_id = g.game_id)
+        RETURNING 1
+      )
+      SELECT (SELECT COUNT(*) FROM upd) + (SELECT COUNT(*) FROM del)
+    `).Scan(&fixed)
+	if err != nil {
+		logger.Error("reconcile failed", zap.Error(err))
+		return 0, mapPgError(err)
+	}
+
+	logger.Info("aggregates reconciled", zap.Int64("fixed", fixed))
+
+	return fixed, nil
+}
+
+func (r *RatingRepository) RecomputeGameRepo(ctx context.Context, gameID string) (err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.RecomputeGameRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("RecomputeGameRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "RecomputeGameRepo"))
+
+	err = pgx.BeginFunc(ctx, r.pg.Pool, func(tx pgx.Tx) error {
+		return recomputeGame(ctx, tx, gameID)
+	})
+	if err != nil {
+		logger.Error("recompute failed", zap.String("game_id", gameID), zap.Error(err))
+		return mapPgError(err)
+	}
+
+	logger.Info("game recomputed", zap.String("game_id", gameID))
+
+	return nil
+}
+
+// DeleteUserRatingsRepo удаляет все оценки пользователя, в том числе скрытые модератором,
+// и пересчитывает затронутые игры. Журнал модерации не трогает.
+func (r *RatingRepository) DeleteUserRatingsRepo(ctx context.Context, userID string) (_ []string, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.DeleteUserRatingsRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("DeleteUserRatingsRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "DeleteUserRatingsRepo"))
+
+	var games []string
+	err = pgx.BeginFunc(ctx, r.pg.Pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM hidden_ratings WHERE user_id = $1`, userID); err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, `DELETE FROM ratings WHERE user_id = $1 RETURNING game_id::text`, userID)
+		if err != nil {
+			return err
+		}
+		games, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
+
+		for _, g := range games {
+			if err := recomputeGame(ctx, tx, g); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error("delete user ratings failed", zap.String("user_id", userID), zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	logger.Info("user ratings deleted", zap.String("user_id", userID), zap.Int("games", len(games)))
+
+	return games, nil
+}
+
+func (r *RatingRepository) GetDistributionRepo(ctx context.Context, gameID string) (_ entity.Distribution, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.GetDistributionRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("GetDistributionRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "GetDistributionRepo"))
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      SELECT ROUND(score)::int AS bucket, COUNT(*)
+      FROM ratings
+      WHERE game_id = $1
+      GROUP BY bucket
+      ORDER BY bucket
+    `, gameID)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return entity.Distribution{}, mapPgError(err)
+	}
+
+	buckets, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.DistributionBucket, error) {
+		var b entity.DistributionBucket
+		err := row.Scan(&b.Score, &b.Count)
+		return b, err
+	})
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return entity.Distribution{}, mapPgError(err)
+	}
+
+	dist := entity.Distribution{GameID: gameID, Buckets: buckets}
+	for _, b := range buckets {
+		dist.Total += b.Count
+	}
+	if dist.Total == 0 {
+		return entity.Distribution{}, entity.ErrGameNotFound
+	}
+
+	return dist, nil
+}
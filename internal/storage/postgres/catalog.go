@@ -0,0 +1,80 @@
+package postgres_storage
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// ApplyCatalogEventRepo сохраняет состояние игры из каталога. Событие старше уже
+// сохранённого игнорируется (false): топик может доставить его повторно или не по порядку.
+func (r *RatingRepository) ApplyCatalogEventRepo(ctx context.Context, meta entity.GameMeta) (_ bool, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ApplyCatalogEventRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ApplyCatalogEventRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ApplyCatalogEventRepo"))
+
+	tags := meta.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	var one int
+	err = r.pg.Pool.QueryRow(ctx, `
+      INSERT INTO game_catalog AS c (game_id, genre, tags, release_year, publisher, developer, deleted, updated_at)
+      VALUES ($1, NULLIF($2, ''), $3, NULLIF($4, 0), NULLIF($5, ''), NULLIF($6, ''), $7, $8)
+      ON CONFLICT (game_id) DO UPDATE
+        SET genre        = EXCLUDED.genre,
+            tags         = EXCLUDED.tags,
+            release_year = EXCLUDED.release_year,
+            publisher    = EXCLUDED.publisher,
+            developer    = EXCLUDED.developer,
+            deleted      = EXCLUDED.deleted,
+            updated_at   = EXCLUDED.updated_at
+        WHERE c.updated_at <= EXCLUDED.updated_at
+      RETURNING 1
+    `, meta.GameID, meta.Genre, tags, meta.ReleaseYear, meta.Publisher, meta.Developer, meta.Deleted,
+		meta.UpdatedAt).Scan(&one)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		logger.Error("upsert failed", zap.String("game_id", meta.GameID), zap.Error(err))
+		return false, mapPgError(err)
+	}
+
+	return true, nil
+}
+
+// topFilterSQL - условие на game_catalog c для фильтра топа; параметры нумеруются с next.
+func topFilterSQL(f entity.TopFilter, next int) (string, []any) {
+	conds := []string{"NOT c.deleted"}
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(next+len(args)-1)
+	}
+
+	if f.Genre != "" {
+		conds = append(conds, "c.genre = "+arg(f.Genre))
+	}
+	if len(f.Tags) > 0 {
+		conds = append(conds, "c.tags @> "+arg(f.Tags)+"::text[]")
+	}
+	if f.ReleaseYear != 0 {
+		conds = append(conds, "c.release_year = "+arg(f.ReleaseYear))
+	}
+	if f.Publisher != "" {
+		conds = append(conds, "lower(c.publisher) = lower("+arg(f.Publisher)+")")
+	}
+
+	return strings.Join(conds, " AND "), args
+}
@@ -0,0 +1,89 @@
+package postgres_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// GetGameScoresRepo - места игр в топе по среднему (в порядке GetTopGamesRepo) и их оценки
+// счётчиками по значениям score. Игры без оценок в ответ не попадают. Для замороженных
+// игр оценки не читаются: среднее, число оценок и место берутся из снимка, как во всех
+// остальных публичных чтениях.
+func (r *RatingRepository) GetGameScoresRepo(ctx context.Context, ids []string) (_ []entity.GameScores, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.GetGameScoresRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("GetGameScoresRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "GetGameScoresRepo"))
+
+	games := make(map[string]*entity.GameScores, len(ids))
+	err = pgx.BeginTxFunc(ctx, r.pg.Pool, pgx.TxOptions{AccessMode: pgx.ReadOnly, IsoLevel: pgx.RepeatableRead},
+		func(tx pgx.Tx) error {
+			rows, err := tx.Query(ctx, `
+              SELECT game_id::text, rank, COALESCE(average_rating, 0)::float8, ratings_count, frozen
+              FROM (
+                SELECT p.*, row_number() OVER (ORDER BY average_rating DESC NULLS LAST, game_id) AS rank
+                FROM (`+publicRatingsSQL+`) p
+                WHERE ratings_count > 0
+              ) t
+              WHERE game_id = ANY($1::text[]::uuid[])
+            `, gameIDs(ids))
+			if err != nil {
+				return err
+			}
+			var g entity.GameScores
+			_, err = pgx.ForEachRow(rows, []any{&g.GameID, &g.Rank, &g.AverageRating, &g.RatingsCount, &g.Frozen},
+				func() error {
+					game := g
+					games[g.GameID] = &game
+					return nil
+				})
+			if err != nil {
+				return err
+			}
+
+			rows, err = tx.Query(ctx, `
+              SELECT r.game_id::text, r.score::float8, COUNT(*)
+              FROM ratings r
+              WHERE r.game_id = ANY($1::text[]::uuid[])
+                AND NOT EXISTS (
+                  SELECT 1 FROM game_freezes f
+                  WHERE f.game_id = r.game_id AND (f.expires_at IS NULL OR f.expires_at > now())
+                )
+              GROUP BY r.game_id, r.score
+              ORDER BY r.game_id, r.score
+            `, gameIDs(ids))
+			if err != nil {
+				return err
+			}
+			var (
+				gameID string
+				sc     entity.ScoreCount
+			)
+			_, err = pgx.ForEachRow(rows, []any{&gameID, &sc.Score, &sc.Count}, func() error {
+				if game := games[gameID]; game != nil {
+					game.Scores = append(game.Scores, sc)
+				}
+				return nil
+			})
+			return err
+		})
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	out := make([]entity.GameScores, 0, len(games))
+	for _, id := range ids {
+		if g := games[id]; g != nil {
+			out = append(out, *g)
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,309 @@
+package postgres_storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	releaseColumns   = `game_id::text, release_at, COALESCE(policy, ''), updated_at`
+	exemptionColumns = `user_id::text, granted_by, reason, created_at`
+)
+
+// GetEmbargoRepo - дата выхода игры, если она ещё не вышла; ok == false - оценки принимаются как обычно.
+func (r *RatingRepository) GetEmbargoRepo(ctx context.Context, gameID string) (_ entity.GameRelease, ok bool, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.GetEmbargoRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("GetEmbargoRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "GetEmbargoRepo"))
+
+	rel, err := scanRelease(r.pg.Pool.QueryRow(ctx, `
+      SELECT `+releaseColumns+`
+      FROM game_releases
+      WHERE game_id = $1 AND release_at > now()
+    `, gameID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.GameRelease{}, false, nil
+		}
+		logger.Error("query failed", zap.Error(err))
+		return entity.GameRelease{}, false, mapPgError(err)
+	}
+
+	return rel, true, nil
+}
+
+// ParkRatingRepo откладывает оценку до выхода игры; повторная оценка заменяет отложенную.
+func (r *RatingRepository) ParkRatingRepo(ctx context.Context, rating entity.Rating) (err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ParkRatingRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ParkRatingRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ParkRatingRepo"))
+
+	if _, err := r.pg.Pool.Exec(ctx, `
+      INSERT INTO parked_ratings(user_id, game_id, rating, scale, score)
+      VALUES ($1, $2, $3, $4, $5)
+      ON CONFLICT (user_id, game_id) DO UPDATE
+        SET rating = EXCLUDED.rating, scale = EXCLUDED.scale, score = EXCLUDED.score, created_at = now()
+    `, rating.UserID, rating.GameID, rating.Value, string(rating.Scale), rating.Score); err != nil {
+		logger.Error("park failed", zap.Error(err))
+		return mapPgError(err)
+	}
+
+	logger.Info("rating parked until release",
+		zap.String("game_id", rating.GameID),
+		zap.String("user_id", rating.UserID),
+	)
+
+	return nil
+}
+
+// ReleaseParkedRepo переносит в ratings отложенные оценки игр, у которых больше нет
+// действующего эмбарго (вышли или дату сняли), и пересчитывает их агрегаты. Если у пары
+// уже есть оценка (её поставил обозреватель) или оценку скрыл модератор, отложенная
+// отбрасывается. Возвращает число перенесённых оценок.
+func (r *RatingRepository) ReleaseParkedRepo(ctx context.Context) (_ int64, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ReleaseParkedRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ReleaseParkedRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ReleaseParkedRepo"))
+
+	var released int64
+	err = pgx.BeginFunc(ctx, r.pg.Pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+          WITH moved AS (
+            DELETE FROM parked_ratings p
+            WHERE NOT EXISTS (
+              SELECT 1 FROM game_releases g WHERE g.game_id = p.game_id AND g.release_at > now()
+            )
+            RETURNING user_id, game_id, rating, scale, score, created_at
+          )
+          INSERT INTO ratings(user_id, game_id, rating, scale, score, created_at)
+          SELECT user_id, game_id, rating, scale, score, created_at
+          FROM moved m
+          WHERE NOT EXISTS (
+            SELECT 1 FROM hidden_ratings h WHERE h.user_id = m.user_id AND h.game_id = m.game_id
+          )
+          ON CONFLICT (user_id, game_id) DO NOTHING
+          RETURNING game_id::text
+        `)
+		if err != nil {
+			return err
+		}
+		games, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
+		released = int64(len(games))
+
+		seen := make(map[string]struct{}, len(games))
+		for _, g := range games {
+			if _, ok := seen[g]; ok {
+				continue
+			}
+			seen[g] = struct{}{}
+			if err := recomputeGame(ctx, tx, g); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error("release failed", zap.Error(err))
+		return 0, mapPgError(err)
+	}
+
+	return released, nil
+}
+
+func (r *RatingRepository) SetReleaseRepo(ctx context.Context, rel entity.GameRelease) (_ entity.GameRelease, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.SetReleaseRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("SetReleaseRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "SetReleaseRepo"))
+
+	rel, err = scanRelease(r.pg.Pool.QueryRow(ctx, `
+      INSERT INTO game_releases(game_id, release_at, policy, updated_at)
+      VALUES ($1, $2, NULLIF($3, ''), now())
+      ON CONFLICT (game_id) DO UPDATE
+        SET release_at = EXCLUDED.release_at, policy = EXCLUDED.policy, updated_at = now()
+      RETURNING `+releaseColumns,
+		rel.GameID, rel.ReleaseAt, string(rel.Policy)))
+	if err != nil {
+		logger.Error("upsert failed", zap.Error(err))
+		return entity.GameRelease{}, mapPgError(err)
+	}
+
+	logger.Info("game release set", zap.String("game_id", rel.GameID), zap.Time("release_at", rel.ReleaseAt))
+
+	return rel, nil
+}
+
+// DeleteReleaseRepo снимает эмбарго; отложенные оценки перенесёт следующий ReleaseParkedRepo.
+func (r *RatingRepository) DeleteReleaseRepo(ctx context.Context, gameID string) (err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.DeleteReleaseRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("DeleteReleaseRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "DeleteReleaseRepo"))
+
+	tag, err := r.pg.Pool.Exec(ctx, `DELETE FROM game_releases WHERE game_id = $1`, gameID)
+	if err != nil {
+		logger.Error("delete failed", zap.Error(err))
+		return mapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.ErrGameNotFound
+	}
+
+	return nil
+}
+
+// ListReleasesRepo - игры, которые ещё не вышли, ближайшие первыми.
+func (r *RatingRepository) ListReleasesRepo(ctx context.Context) (_ []entity.GameRelease, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ListReleasesRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ListReleasesRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ListReleasesRepo"))
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      SELECT `+releaseColumns+`
+      FROM game_releases
+      WHERE release_at > now()
+      ORDER BY release_at
+    `)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	releases, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.GameRelease, error) {
+		return scanRelease(row)
+	})
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	return releases, nil
+}
+
+// IsEmbargoExemptRepo - есть ли пользователь в списке тех, кто оценивает игры до выхода.
+func (r *RatingRepository) IsEmbargoExemptRepo(ctx context.Context, userID string) (_ bool, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.IsEmbargoExemptRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("IsEmbargoExemptRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "IsEmbargoExemptRepo"))
+
+	var exempt bool
+	if err := r.pg.Pool.QueryRow(ctx, `
+      SELECT EXISTS (SELECT 1 FROM embargo_exemptions WHERE user_id = $1)
+    `, userID).Scan(&exempt); err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return false, mapPgError(err)
+	}
+
+	return exempt, nil
+}
+
+func (r *RatingRepository) GrantExemptionRepo(ctx context.Context, e entity.EmbargoExemption) (_ entity.EmbargoExemption, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.GrantExemptionRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("GrantExemptionRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "GrantExemptionRepo"))
+
+	e, err = scanExemption(r.pg.Pool.QueryRow(ctx, `
+      INSERT INTO embargo_exemptions(user_id, granted_by, reason)
+      VALUES ($1, $2, $3)
+      ON CONFLICT (user_id) DO UPDATE
+        SET granted_by = EXCLUDED.granted_by, reason = EXCLUDED.reason
+      RETURNING `+exemptionColumns,
+		e.UserID, e.GrantedBy, e.Reason))
+	if err != nil {
+		logger.Error("upsert failed", zap.Error(err))
+		return entity.EmbargoExemption{}, mapPgError(err)
+	}
+
+	logger.Info("embargo exemption granted", zap.String("user_id", e.UserID), zap.String("granted_by", e.GrantedBy))
+
+	return e, nil
+}
+
+func (r *RatingRepository) RevokeExemptionRepo(ctx context.Context, userID string) (err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.RevokeExemptionRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("RevokeExemptionRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "RevokeExemptionRepo"))
+
+	tag, err := r.pg.Pool.Exec(ctx, `DELETE FROM embargo_exemptions WHERE user_id = $1`, userID)
+	if err != nil {
+		logger.Error("delete failed", zap.Error(err))
+		return mapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.ErrNotExempt
+	}
+
+	logger.Info("embargo exemption revoked", zap.String("user_id", userID))
+
+	return nil
+}
+
+// ListExemptionsRepo - список исключений, новые первыми.
+func (r *RatingRepository) ListExemptionsRepo(ctx context.Context) (_ []entity.EmbargoExemption, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ListExemptionsRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ListExemptionsRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ListExemptionsRepo"))
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      SELECT `+exemptionColumns+`
+      FROM embargo_exemptions
+      ORDER BY created_at DESC, user_id
+    `)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	users, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.EmbargoExemption, error) {
+		return scanExemption(row)
+	})
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	return users, nil
+}
+
+func scanRelease(row pgx.Row) (entity.GameRelease, error) {
+	var (
+		rel    entity.GameRelease
+		policy string
+	)
+	err := row.Scan(&rel.GameID, &rel.ReleaseAt, &policy, &rel.UpdatedAt)
+	rel.Policy = entity.EmbargoPolicy(policy)
+	return rel, err
+}
+
+func scanExemption(row pgx.Row) (entity.EmbargoExemption, error) {
+	var e entity.EmbargoExemption
+	err := row.Scan(&e.UserID, &e.GrantedBy, &e.Reason, &e.CreatedAt)
+	return e, err
+}
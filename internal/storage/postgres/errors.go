@@ -0,0 +1,64 @@
+package postgres_storage
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	pgUniqueViolation      = "23505"
+	pgCheckViolation       = "23514"
+	pgInvalidTextRepr      = "22P02"
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+	pgClassConnection      = "08"
+	pgClassResources       = "53"
+	pgClassOperatorAction  = "57"
+)
+
+// ratingChecks - CHECK-ограничения ratings на саму оценку (001, 003). Нарушение других
+// CHECK - ошибка сервиса, а не клиента, и уходит наружу как internal.
+var ratingChecks = map[string]bool{
+	"ratings_rating_check": true,
+	"ratings_score_check":  true,
+	"ratings_scale_check":  true,
+}
+
+// mapPgError переводит ошибки Postgres в доменные ошибки entity.
+func mapPgError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == pgUniqueViolation,
+			pgErr.Code == pgSerializationFailure,
+			pgErr.Code == pgDeadlockDetected:
+			return &entity.RetryableError{Err: entity.ErrConflict, Reason: pgErr.Message}
+		case pgErr.Code == pgCheckViolation && ratingChecks[pgErr.ConstraintName]:
+			return &entity.ValidationError{Fields: []*entity.FieldError{{
+				Field: "rating", Err: entity.ErrInvalidScore, Description: "rating is out of allowed range",
+			}}}
+		case pgErr.Code == pgInvalidTextRepr:
+			// колонку для 22P02 Postgres не сообщает, поэтому без поля: поля запроса
+			// проверяет валидатор до запроса
+			return fmt.Errorf("%w: %s", entity.ErrInvalidArgument, pgErr.Message)
+		case len(pgErr.Code) >= 2 && (pgErr.Code[:2] == pgClassConnection ||
+			pgErr.Code[:2] == pgClassResources || pgErr.Code[:2] == pgClassOperatorAction):
+			return &entity.RetryableError{Err: entity.ErrUnavailable, Reason: pgErr.Message}
+		}
+		return err
+	}
+
+	var connErr *pgconn.ConnectError
+	if errors.As(err, &connErr) || pgconn.SafeToRetry(err) || pgconn.Timeout(err) {
+		return &entity.RetryableError{Err: entity.ErrUnavailable, Reason: err.Error()}
+	}
+
+	return err
+}
@@ -0,0 +1,121 @@
+package postgres_storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// ExportRepo читает выбранные наборы данных в одной read-only repeatable read
+// транзакции: ratings и game_ratings видят один и тот же снимок, и счётчики сходятся.
+func (r *RatingRepository) ExportRepo(ctx context.Context, filter entity.ExportFilter,
+	onRating func(entity.ExportRating) error, onGame func(entity.ExportGameRating) error) (err error) {
+
+	ctx, span := tracer.Start(ctx, "RatingRepository.ExportRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ExportRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ExportRepo"))
+
+	err = pgx.BeginTxFunc(ctx, r.pg.Pool, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	}, func(tx pgx.Tx) error {
+		for _, ds := range filter.Datasets {
+			var err error
+			switch ds {
+			case entity.DatasetRatings:
+				err = exportRatings(ctx, tx, filter, onRating)
+			case entity.DatasetGameRatings:
+				err = exportGameRatings(ctx, tx, filter, onGame)
+			default:
+				err = fmt.Errorf("unknown dataset %q", ds)
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", ds, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error("export failed", zap.Error(err))
+		return mapPgError(err)
+	}
+
+	return nil
+}
+
+func exportRatings(ctx context.Context, tx pgx.Tx, filter entity.ExportFilter, fn func(entity.ExportRating) error) error {
+	rows, err := tx.Query(ctx, `
+      SELECT user_id::text, game_id::text, rating, scale, score, created_at
+      FROM ratings
+      WHERE ($1::timestamptz IS NULL OR created_at >= $1)
+        AND ($2::timestamptz IS NULL OR created_at <  $2)
+        AND (cardinality($3::text[]) = 0 OR game_id = ANY($3::text[]::uuid[]))
+      ORDER BY game_id, user_id
+    `, nullTime(filter.From), nullTime(filter.To), gameIDs(filter.GameIDs))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			rec   entity.ExportRating
+			scale string
+		)
+		if err := rows.Scan(&rec.UserID, &rec.GameID, &rec.Rating, &scale, &rec.Score, &rec.CreatedAt); err != nil {
+			return err
+		}
+		rec.Scale = entity.Scale(scale)
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func exportGameRatings(ctx context.Context, tx pgx.Tx, filter entity.ExportFilter, fn func(entity.ExportGameRating) error) error {
+	rows, err := tx.Query(ctx, `
+      SELECT game_id::text, ratings_count, ratings_sum, COALESCE(average_rating, 0)
+      FROM game_ratings
+      WHERE cardinality($1::text[]) = 0 OR game_id = ANY($1::text[]::uuid[])
+      ORDER BY game_id
+    `, gameIDs(filter.GameIDs))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rec entity.ExportGameRating
+		if err := rows.Scan(&rec.GameID, &rec.RatingsCount, &rec.RatingsSum, &rec.AverageRating); err != nil {
+			return err
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// nullTime - нулевое время как NULL, чтобы фильтр по границе отключался.
+func nullTime(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+// gameIDs - nil-срез pgx отправит как NULL, а cardinality(NULL) - не 0.
+func gameIDs(ids []string) []string {
+	if ids == nil {
+		return []string{}
+	}
+	return ids
+}
@@ -0,0 +1,194 @@
+package postgres_storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const freezeColumns = `
+    game_id::text, frozen_at, expires_at, moderator, reason,
+    COALESCE(average_rating, 0)::float8, ratings_count, normalized_rating::float8, weighted_average::float8,
+    verified_average::float8, verified_count
+`
+
+// FreezeGameRepo замораживает игру со снимком текущего рейтинга. Повторная заморозка
+// действующей заморозки меняет только срок, модератора и причину - снимок остаётся прежним;
+// истёкшая заморозка заменяется целиком. Игра без оценок - entity.ErrGameNotFound.
+func (r *RatingRepository) FreezeGameRepo(ctx context.Context, f entity.GameFreeze) (_ entity.GameFreeze, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.FreezeGameRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("FreezeGameRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "FreezeGameRepo"))
+
+	row := r.pg.Pool.QueryRow(ctx, `
+      INSERT INTO game_freezes AS f (game_id, expires_at, moderator, reason,
+                                     average_rating, ratings_count, normalized_rating, weighted_average,
+                                     verified_average, verified_count)
+      SELECT $1::uuid, $2, $3, $4,
+             g.average_rating, g.ratings_count, n.normalized_rating, g.weighted_average,
+             g.verified_average, g.verified_count
+      FROM game_ratings g
+      LEFT JOIN game_normalized_ratings n ON n.game_id = g.game_id
+      WHERE g.game_id = $1::uuid
+      ON CONFLICT (game_id) DO UPDATE
+        SET expires_at        = EXCLUDED.expires_at,
+            moderator         = EXCLUDED.moderator,
+            reason            = EXCLUDED.reason,
+            frozen_at         = CASE WHEN f.expires_at <= now() THEN now() ELSE f.frozen_at END,
+            average_rating    = CASE WHEN f.expires_at <= now() THEN EXCLUDED.average_rating ELSE f.average_rating END,
+            ratings_count     = CASE WHEN f.expires_at <= now() THEN EXCLUDED.ratings_count ELSE f.ratings_count END,
+            normalized_rating = CASE WHEN f.expires_at <= now() THEN EXCLUDED.normalized_rating ELSE f.normalized_rating END,
+            weighted_average  = CASE WHEN f.expires_at <= now() THEN EXCLUDED.weighted_average ELSE f.weighted_average END,
+            verified_average  = CASE WHEN f.expires_at <= now() THEN EXCLUDED.verified_average ELSE f.verified_average END,
+            verified_count    = CASE WHEN f.expires_at <= now() THEN EXCLUDED.verified_count ELSE f.verified_count END
+      RETURNING `+freezeColumns,
+		f.GameID, nullTime(f.ExpiresAt), f.Moderator, f.Reason)
+
+	frozen, err := scanFreeze(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.GameFreeze{}, entity.ErrGameNotFound
+		}
+		logger.Error("freeze failed", zap.String("game_id", f.GameID), zap.Error(err))
+		return entity.GameFreeze{}, mapPgError(err)
+	}
+
+	logger.Info("game frozen",
+		zap.String("game_id", frozen.GameID),
+		zap.String("moderator", frozen.Moderator),
+		zap.Time("expires_at", frozen.ExpiresAt),
+	)
+
+	return frozen, nil
+}
+
+// UnfreezeGameRepo снимает заморозку; подписчики сразу получают накопленные изменения.
+// Нет действующей заморозки - entity.ErrNotFrozen.
+func (r *RatingRepository) UnfreezeGameRepo(ctx context.Context, gameID string) (_ entity.GameFreeze, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.UnfreezeGameRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("UnfreezeGameRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "UnfreezeGameRepo"))
+
+	var f entity.GameFreeze
+	err = pgx.BeginFunc(ctx, r.pg.Pool, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, `
+          DELETE FROM game_freezes
+          WHERE game_id = $1 AND (expires_at IS NULL OR expires_at > now())
+          RETURNING `+freezeColumns, gameID)
+		if f, err = scanFreeze(row); err != nil {
+			return err
+		}
+		return touchGameRatings(ctx, tx, []string{gameID})
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.GameFreeze{}, entity.ErrNotFrozen
+		}
+		logger.Error("unfreeze failed", zap.String("game_id", gameID), zap.Error(err))
+		return entity.GameFreeze{}, mapPgError(err)
+	}
+
+	logger.Info("game unfrozen", zap.String("game_id", gameID))
+
+	return f, nil
+}
+
+// ExpireFreezesRepo удаляет истёкшие заморозки и возвращает их игры. Читатели перестают
+// видеть снимок сразу по истечении срока, а здесь лишь убирается запись и будятся подписчики.
+func (r *RatingRepository) ExpireFreezesRepo(ctx context.Context) (_ []string, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ExpireFreezesRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ExpireFreezesRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ExpireFreezesRepo"))
+
+	var games []string
+	err = pgx.BeginFunc(ctx, r.pg.Pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+          DELETE FROM game_freezes
+          WHERE expires_at <= now()
+          RETURNING game_id::text
+        `)
+		if err != nil {
+			return err
+		}
+		if games, err = pgx.CollectRows(rows, pgx.RowTo[string]); err != nil {
+			return err
+		}
+		return touchGameRatings(ctx, tx, games)
+	})
+	if err != nil {
+		logger.Error("expire failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	return games, nil
+}
+
+// ListFreezesRepo - действующие заморозки, самые свежие первыми.
+func (r *RatingRepository) ListFreezesRepo(ctx context.Context) (_ []entity.GameFreeze, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ListFreezesRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ListFreezesRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ListFreezesRepo"))
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      SELECT `+freezeColumns+`
+      FROM game_freezes
+      WHERE expires_at IS NULL OR expires_at > now()
+      ORDER BY frozen_at DESC
+    `)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	freezes, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.GameFreeze, error) {
+		return scanFreeze(row)
+	})
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	return freezes, nil
+}
+
+// touchGameRatings - пустое обновление строк game_ratings, чтобы триггер 004 разослал
+// подписчикам текущие значения размороженных игр.
+func touchGameRatings(ctx context.Context, tx pgx.Tx, games []string) error {
+	if len(games) == 0 {
+		return nil
+	}
+	_, err := tx.Exec(ctx, `
+      UPDATE game_ratings SET game_id = game_id
+      WHERE game_id = ANY($1::text[]::uuid[])
+    `, games)
+	return err
+}
+
+func scanFreeze(row pgx.Row) (entity.GameFreeze, error) {
+	var (
+		f       entity.GameFreeze
+		expires *time.Time
+	)
+	err := row.Scan(&f.GameID, &f.FrozenAt, &expires, &f.Moderator, &f.Reason,
+		&f.Snapshot.AverageRating, &f.Snapshot.RatingsCount, &f.Snapshot.NormalizedRating, &f.Snapshot.WeightedRating,
+		&f.Snapshot.VerifiedRating, &f.Snapshot.VerifiedCount)
+	if expires != nil {
+		f.ExpiresAt = *expires
+	}
+	f.Snapshot.GameId = f.GameID
+	f.Snapshot.Frozen = true
+	return f, err
+}
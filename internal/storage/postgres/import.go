@@ -0,0 +1,218 @@
+package postgres_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// mergeImportSQL переносит import_ratings в ratings и возвращает game_id и признак
+// вставки для каждой записанной строки. Пары, скрытые модератором, пропускаются.
+// Агрегаты затронутых игр пересчитываются после слияния через recomputeGame.
+const mergeImportSQL = `
+    INSERT INTO ratings(user_id, game_id, rating, scale, score, created_at)
+    SELECT user_id, game_id, rating, scale, score, COALESCE(created_at, now())
+    FROM import_ratings s
+    WHERE NOT EXISTS (
+      SELECT 1 FROM hidden_ratings h WHERE h.user_id = s.user_id AND h.game_id = s.game_id
+    )
+    ON CONFLICT (user_id, game_id)
+    DO UPDATE SET rating = EXCLUDED.rating, scale = EXCLUDED.scale, score = EXCLUDED.score
+    RETURNING game_id::text, (xmax = 0) AS inserted
+`
+
+// embargoImportSQL выносит из import_ratings строки игр под действующим эмбарго от
+// пользователей не из embargo_exemptions - как SubmitRating: при политике park строка
+// откладывается в parked_ratings, при reject отбрасывается. Пустая политика игры
+// заменяется $1 (политика из конфига). Возвращает вынесенные пары.
+const embargoImportSQL = `
+    WITH emb AS (
+      SELECT s.user_id, s.game_id, s.rating, s.scale, s.score, s.created_at,
+             COALESCE(g.policy, $1) AS policy, g.release_at
+      FROM import_ratings s
+      JOIN game_releases g ON g.game_id = s.game_id AND g.release_at > now()
+      WHERE NOT EXISTS (SELECT 1 FROM embargo_exemptions e WHERE e.user_id = s.user_id)
+    ), parked AS (
+      INSERT INTO parked_ratings(user_id, game_id, rating, scale, score, created_at)
+      SELECT user_id, game_id, rating, scale, score, COALESCE(created_at, now())
+      FROM emb
+      WHERE policy = 'park'
+      ON CONFLICT (user_id, game_id) DO UPDATE
+        SET rating = EXCLUDED.rating, scale = EXCLUDED.scale, score = EXCLUDED.score,
+            created_at = EXCLUDED.created_at
+    ), dropped AS (
+      DELETE FROM import_ratings s
+      USING emb e
+      WHERE s.user_id = e.user_id AND s.game_id = e.game_id
+    )
+    SELECT user_id::text, game_id::text, policy, release_at FROM emb
+`
+
+// ImportRatingsRepo грузит строки через COPY во временную таблицу и сливает их
+// с ratings, после чего пересчитывает агрегаты затронутых игр по таблице ratings.
+// Строки неизданных игр откладываются или отклоняются по эмбарго (embargoImportSQL),
+// отклонённые возвращаются в ImportStats.Embargoed. Пары (user_id, game_id) в rows должны быть уникальны.
+func (r *RatingRepository) ImportRatingsRepo(ctx context.Context, rows []entity.ImportRow, dryRun bool,
+	embargoPolicy entity.EmbargoPolicy) (_ entity.ImportStats, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ImportRatingsRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ImportRatingsRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ImportRatingsRepo"))
+
+	tx, err := r.pg.Pool.Begin(ctx)
+	if err != nil {
+		logger.Error("Begin tx failed", zap.Error(err))
+		return entity.ImportStats{}, mapPgError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+      CREATE TEMP TABLE import_ratings (
+        user_id    UUID         NOT NULL,
+        game_id    UUID         NOT NULL,
+        rating     SMALLINT     NOT NULL,
+        scale      TEXT         NOT NULL,
+        score      NUMERIC(4,2) NOT NULL,
+        created_at TIMESTAMPTZ,
+        PRIMARY KEY (user_id, game_id)
+      ) ON COMMIT DROP
+    `)
+	if err != nil {
+		logger.Error("create staging table failed", zap.Error(err))
+		return entity.ImportStats{}, mapPgError(err)
+	}
+
+	copied, err := tx.CopyFrom(ctx,
+		pgx.Identifier{"import_ratings"},
+		[]string{"user_id", "game_id", "rating", "scale", "score", "created_at"},
+		pgx.CopyFromSlice(len(rows), func(i int) ([]any, error) {
+			row := rows[i]
+			// binary COPY не кодирует строки в uuid; строки обычно уже провалидированы,
+			// но невалидная не должна ронять процесс
+			userID, err := uuid.Parse(row.Rating.UserID)
+			if err != nil {
+				return nil, &entity.LineError{Line: row.Line, Err: &entity.FieldError{
+					Field: "user_id", Err: entity.ErrInvalidUUID, Description: "user_id must be a valid UUID"}}
+			}
+			gameID, err := uuid.Parse(row.Rating.GameID)
+			if err != nil {
+				return nil, &entity.LineError{Line: row.Line, Err: &entity.FieldError{
+					Field: "game_id", Err: entity.ErrInvalidUUID, Description: "game_id must be a valid UUID"}}
+			}
+			return []any{
+				[16]byte(userID),
+				[16]byte(gameID),
+				int16(row.Rating.Value),
+				string(row.Rating.Scale),
+				row.Rating.Score,
+				row.CreatedAt,
+			}, nil
+		}),
+	)
+	if err != nil {
+		logger.Error("copy into staging table failed", zap.Error(err))
+		return entity.ImportStats{}, mapPgError(err)
+	}
+
+	var stats entity.ImportStats
+	lines := make(map[string]int, len(rows))
+	for _, row := range rows {
+		lines[row.Rating.UserID+"/"+row.Rating.GameID] = row.Line
+	}
+
+	embargoed, err := tx.Query(ctx, embargoImportSQL, string(embargoPolicy))
+	if err != nil {
+		logger.Error("embargo check failed", zap.Error(err))
+		return entity.ImportStats{}, mapPgError(err)
+	}
+	var (
+		userID    string
+		gameID    string
+		policy    string
+		releaseAt time.Time
+	)
+	skipped := make(map[string]struct{})
+	_, err = pgx.ForEachRow(embargoed, []any{&userID, &gameID, &policy, &releaseAt}, func() error {
+		key := userID + "/" + gameID
+		skipped[key] = struct{}{}
+		if entity.EmbargoPolicy(policy) == entity.EmbargoPark {
+			stats.Parked++
+			return nil
+		}
+		stats.Embargoed = append(stats.Embargoed, &entity.LineError{
+			Line: lines[key],
+			Err:  &entity.EmbargoError{GameID: gameID, ReleaseAt: releaseAt},
+		})
+		return nil
+	})
+	if err != nil {
+		logger.Error("embargo check failed", zap.Error(err))
+		return entity.ImportStats{}, mapPgError(err)
+	}
+
+	games := make(map[string]struct{})
+	merged, err := tx.Query(ctx, mergeImportSQL)
+	if err != nil {
+		logger.Error("merge failed", zap.Error(err))
+		return entity.ImportStats{}, mapPgError(err)
+	}
+	var inserted bool
+	_, err = pgx.ForEachRow(merged, []any{&gameID, &inserted}, func() error {
+		if inserted {
+			stats.Inserted++
+		} else {
+			stats.Updated++
+		}
+		games[gameID] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		logger.Error("merge failed", zap.Error(err))
+		return entity.ImportStats{}, mapPgError(err)
+	}
+
+	// пересчёт с нуля, а не дельты: параллельные оценки и модерация не сдвигают агрегат
+	for g := range games {
+		if err := recomputeGame(ctx, tx, g); err != nil {
+			logger.Error("recompute failed", zap.String("game_id", g), zap.Error(err))
+			return entity.ImportStats{}, mapPgError(err)
+		}
+	}
+	stats.Games = int64(len(games))
+
+	if dryRun {
+		logger.Info("dry run, rolling back", zap.Int64("copied", copied))
+		return stats, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Error("commit tx failed", zap.Error(err))
+		return entity.ImportStats{}, mapPgError(err)
+	}
+
+	r.metrics.RatingsWritten.WithLabelValues("new").Add(float64(stats.Inserted))
+	r.metrics.RatingsWritten.WithLabelValues("updated").Add(float64(stats.Updated))
+	for _, row := range rows {
+		if _, ok := skipped[row.Rating.UserID+"/"+row.Rating.GameID]; ok {
+			continue
+		}
+		r.metrics.ScoreDistribution.Observe(row.Rating.Score)
+	}
+
+	logger.Info("ratings imported",
+		zap.Int64("copied", copied),
+		zap.Int64("inserted", stats.Inserted),
+		zap.Int64("updated", stats.Updated),
+		zap.Int64("parked", stats.Parked),
+		zap.Int("embargoed", len(stats.Embargoed)),
+		zap.Int64("games", stats.Games),
+	)
+
+	return stats, nil
+}
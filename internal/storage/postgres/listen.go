@@ -0,0 +1,66 @@
+package postgres_storage
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"go.uber.org/zap"
+)
+
+// gameRatingsChannel - канал NOTIFY из триггера game_ratings_notify (миграция 004).
+const gameRatingsChannel = "game_ratings"
+
+type gameRatingNotification struct {
+	GameID        string  `json:"game_id"`
+	AverageRating float64 `json:"average_rating"`
+	RatingsCount  int64   `json:"ratings_count"`
+}
+
+// ListenGameRatingsRepo слушает изменения game_ratings, пока не упадёт соединение или не отменят ctx.
+// Соединение забирается из пула насовсем (Hijack), чтобы LISTEN не занимал слот пула и не
+// вернулся в него подписанным. onListen вызывается, когда LISTEN выполнен: уведомления
+// с этого момента не теряются. Переподключение - забота вызывающего.
+func (r *RatingRepository) ListenGameRatingsRepo(ctx context.Context, onListen func(), fn func(entity.GameRatingUpdate)) error {
+	logger := r.logger.With(zap.String("func", "ListenGameRatingsRepo"))
+
+	pooled, err := r.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return mapPgError(err)
+	}
+	conn := pooled.Hijack()
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
+		defer cancel()
+		_ = conn.Close(closeCtx)
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+gameRatingsChannel); err != nil {
+		return mapPgError(err)
+	}
+	logger.Info("listening for game rating updates")
+	onListen()
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return mapPgError(err)
+		}
+
+		var msg gameRatingNotification
+		if err := json.Unmarshal([]byte(n.Payload), &msg); err != nil {
+			logger.Warn("bad notification payload", zap.String("payload", n.Payload), zap.Error(err))
+			continue
+		}
+
+		fn(entity.GameRatingUpdate{
+			GameRating: entity.GameRating{
+				GameId:        msg.GameID,
+				AverageRating: msg.AverageRating,
+				RatingsCount:  msg.RatingsCount,
+			},
+			UpdatedAt: time.Now(),
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package postgres_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// moderationTargetSQL - условие на оценки цели действия ($1..$4 - user, game, from, to).
+const moderationTargetSQL = `
+    user_id = $1
+    AND (NULLIF($2, '')::uuid IS NULL OR game_id = NULLIF($2, '')::uuid)
+    AND ($3::timestamptz IS NULL OR created_at >= $3)
+    AND ($4::timestamptz IS NULL OR created_at < $4)
+`
+
+// hideRatingsSQL переносит оценки цели в hidden_ratings; $5 - id действия.
+const hideRatingsSQL = `
+    WITH moved AS (
+      DELETE FROM ratings
+      WHERE ` + moderationTargetSQL + `
+      RETURNING user_id, game_id, rating, scale, score, created_at
+    )
+    INSERT INTO hidden_ratings(user_id, game_id, rating, scale, score, created_at, action_id)
+    SELECT user_id, game_id, rating, scale, score, created_at, $5
+    FROM moved
+    RETURNING game_id::text
+`
+
+// unhideRatingsSQL возвращает скрытые оценки цели в ratings. Если пара уже есть в ratings
+// (например, её залили импортом), остаётся та, что в ratings.
+const unhideRatingsSQL = `
+    WITH moved AS (
+      DELETE FROM hidden_ratings
+      WHERE ` + moderationTargetSQL + `
+      RETURNING user_id, game_id, rating, scale, score, created_at
+    )
+    INSERT INTO ratings(user_id, game_id, rating, scale, score, created_at)
+    SELECT user_id, game_id, rating, scale, score, created_at
+    FROM moved
+    ON CONFLICT (user_id, game_id) DO NOTHING
+    RETURNING game_id::text
+`
+
+// ModerateRatingsRepo скрывает или возвращает оценки цели action, пересчитывает агрегаты
+// затронутых игр и пишет действие в журнал - всё в одной транзакции. Возвращает записанное
+// действие с id, числом затронутых оценок и списком игр.
+func (r *RatingRepository) ModerateRatingsRepo(ctx context.Context, action entity.ModerationAction) (_ entity.ModerationAction, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ModerateRatingsRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ModerateRatingsRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ModerateRatingsRepo"))
+
+	query := hideRatingsSQL
+	if action.Type == entity.ModerationUnhide {
+		query = unhideRatingsSQL
+	}
+	t := action.Target
+
+	err = pgx.BeginFunc(ctx, r.pg.Pool, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, `
+          INSERT INTO moderation_actions(action, moderator, reason, user_id, game_id, window_from, window_to)
+          VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, $6, $7)
+          RETURNING id, created_at
+        `, string(action.Type), action.Moderator, action.Reason, t.UserID, t.GameID, nullTime(t.From), nullTime(t.To),
+		).Scan(&action.ID, &action.CreatedAt); err != nil {
+			return err
+		}
+
+		args := []any{t.UserID, t.GameID, nullTime(t.From), nullTime(t.To)}
+		if action.Type == entity.ModerationHide {
+			args = append(args, action.ID)
+		}
+		rows, err := tx.Query(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		games, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
+		action.Affected = int64(len(games))
+
+		// у одного пользователя на игру одна оценка, так что повторов в games нет
+		for _, g := range games {
+			if err := recomputeGame(ctx, tx, g); err != nil {
+				return err
+			}
+		}
+		action.Games = games
+
+		_, err = tx.Exec(ctx, `UPDATE moderation_actions SET affected = $2 WHERE id = $1`, action.ID, action.Affected)
+		return err
+	})
+	if err != nil {
+		logger.Error("moderation failed", zap.String("action", string(action.Type)),
+			zap.String("user_id", t.UserID), zap.Error(err))
+		return entity.ModerationAction{}, mapPgError(err)
+	}
+
+	logger.Info("ratings moderated",
+		zap.Int64("action_id", action.ID),
+		zap.String("action", string(action.Type)),
+		zap.String("moderator", action.Moderator),
+		zap.String("user_id", t.UserID),
+		zap.Int64("affected", action.Affected),
+	)
+
+	return action, nil
+}
+
+// ListModerationActionsRepo - страница журнала модерации, новые действия первыми.
+func (r *RatingRepository) ListModerationActionsRepo(ctx context.Context, f entity.ModerationFilter) (_ []entity.ModerationAction, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ListModerationActionsRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ListModerationActionsRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ListModerationActionsRepo"))
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      SELECT id, action, moderator, reason, user_id::text, COALESCE(game_id::text, ''),
+             window_from, window_to, affected, created_at
+      FROM moderation_actions
+      WHERE (NULLIF($1, '')::uuid IS NULL OR user_id = NULLIF($1, '')::uuid)
+        AND (NULLIF($2, '')::uuid IS NULL OR game_id = NULLIF($2, '')::uuid)
+        AND ($3 = '' OR moderator = $3)
+        AND ($4::bigint = 0 OR id < $4::bigint)
+      ORDER BY id DESC
+      LIMIT $5
+    `, f.UserID, f.GameID, f.Moderator, f.BeforeID, f.Limit)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	actions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.ModerationAction, error) {
+		var (
+			a        entity.ModerationAction
+			typ      string
+			from, to *time.Time
+		)
+		err := row.Scan(&a.ID, &typ, &a.Moderator, &a.Reason, &a.Target.UserID, &a.Target.GameID,
+			&from, &to, &a.Affected, &a.CreatedAt)
+		a.Type = entity.ModerationActionType(typ)
+		if from != nil {
+			a.Target.From = *from
+		}
+		if to != nil {
+			a.Target.To = *to
+		}
+		return a, err
+	})
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	return actions, nil
+}
@@ -0,0 +1,80 @@
+package postgres_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// normalizeSQL пересчитывает нормализованный рейтинг всех игр. dev - отклонение оценки от
+// средней пользователя (у пользователей с малым числом оценок - от общей средней), для zscore
+// ещё и делённое на разброс. Среднее dev по игре возвращается на шкалу через общие среднюю
+// и разброс. Пользователь, который всем ставит одно и то же, для zscore даёт dev = 0.
+const normalizeSQL = `
+    WITH users AS (
+      SELECT user_id, AVG(score)::float8 AS mu, STDDEV_POP(score)::float8 AS sd, COUNT(*) AS n
+      FROM ratings
+      GROUP BY user_id
+    ), total AS (
+      SELECT AVG(score)::float8 AS mu, STDDEV_POP(score)::float8 AS sd
+      FROM ratings
+    ), dev AS (
+      SELECT r.game_id,
+             CASE WHEN u.n < $2 THEN r.score - t.mu ELSE r.score - u.mu END
+               / CASE WHEN $1 = 'zscore'
+                   THEN COALESCE(NULLIF(CASE WHEN u.n < $2 THEN t.sd ELSE u.sd END, 0), 'Infinity')
+                   ELSE 1 END AS dev
+      FROM ratings r
+      JOIN users u USING (user_id)
+      CROSS JOIN total t
+    )
+    INSERT INTO game_normalized_ratings(game_id, normalized_rating, ratings_count, method, computed_at)
+    SELECT d.game_id,
+           ROUND(LEAST(GREATEST(
+             t.mu + AVG(d.dev) * CASE WHEN $1 = 'zscore' THEN t.sd ELSE 1 END,
+             $3), $4)::numeric, 2),
+           COUNT(*), $1, now()
+    FROM dev d
+    CROSS JOIN total t
+    GROUP BY d.game_id, t.mu, t.sd
+    ON CONFLICT (game_id) DO UPDATE
+      SET normalized_rating = EXCLUDED.normalized_rating,
+          ratings_count     = EXCLUDED.ratings_count,
+          method            = EXCLUDED.method,
+          computed_at       = EXCLUDED.computed_at
+`
+
+// RecomputeNormalizedRepo пересчитывает нормализованный рейтинг всех игр и возвращает их число.
+func (r *RatingRepository) RecomputeNormalizedRepo(ctx context.Context, params entity.NormalizationParams) (_ int64, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.RecomputeNormalizedRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("RecomputeNormalizedRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "RecomputeNormalizedRepo"))
+
+	var games int64
+	err = pgx.BeginFunc(ctx, r.pg.Pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, normalizeSQL, string(params.Method), params.MinUserRatings,
+			entity.ScoreMin, entity.ScoreMax)
+		if err != nil {
+			return err
+		}
+		games = tag.RowsAffected()
+
+		_, err = tx.Exec(ctx, `
+          DELETE FROM game_normalized_ratings n
+          WHERE NOT EXISTS (SELECT 1 FROM ratings r WHERE r.game_id = n.game_id)
+        `)
+		return err
+	})
+	if err != nil {
+		logger.Error("recompute failed", zap.Error(err))
+		return 0, mapPgError(err)
+	}
+
+	return games, nil
+}
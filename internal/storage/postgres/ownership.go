@@ -0,0 +1,92 @@
+package postgres_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// ApplyOwnershipEventRepo переносит событие владения в player_games и пересчитывает
+// verified по порогу minPlaytimeMinutes. Владение меняет только событие не старше
+// уже учтённого, наигранное время только растёт - так переотправка и перестановка
+// событий безопасны. Агрегаты проверенных игроков обновляет триггер (миграция 012).
+func (r *RatingRepository) ApplyOwnershipEventRepo(ctx context.Context, ev entity.OwnershipEvent,
+	minPlaytimeMinutes int64) (_ entity.PlayerGame, err error) {
+
+	ctx, span := tracer.Start(ctx, "RatingRepository.ApplyOwnershipEventRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ApplyOwnershipEventRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ApplyOwnershipEventRepo"))
+
+	var (
+		owned   bool
+		ownedAt any
+	)
+	switch ev.Type {
+	case entity.OwnershipAcquired:
+		owned, ownedAt = true, ev.OccurredAt
+	case entity.OwnershipRevoked:
+		owned, ownedAt = false, ev.OccurredAt
+	}
+
+	var pg entity.PlayerGame
+	err = pgx.BeginFunc(ctx, r.pg.Pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `
+          INSERT INTO player_games AS p (user_id, game_id, owned, owned_event_at, playtime_minutes, updated_at)
+          VALUES ($1, $2, $3, $4, $5, now())
+          ON CONFLICT (user_id, game_id) DO UPDATE
+            SET owned = CASE
+                  WHEN EXCLUDED.owned_event_at IS NOT NULL
+                   AND (p.owned_event_at IS NULL OR EXCLUDED.owned_event_at >= p.owned_event_at)
+                  THEN EXCLUDED.owned
+                  ELSE p.owned
+                END,
+                owned_event_at   = GREATEST(p.owned_event_at, EXCLUDED.owned_event_at),
+                playtime_minutes = GREATEST(p.playtime_minutes, EXCLUDED.playtime_minutes),
+                updated_at       = now()
+        `, ev.UserID, ev.GameID, owned, ownedAt, ev.PlaytimeMinutes); err != nil {
+			return err
+		}
+
+		return tx.QueryRow(ctx, `
+          UPDATE player_games
+          SET verified = owned AND playtime_minutes >= $3
+          WHERE user_id = $1 AND game_id = $2
+          RETURNING user_id::text, game_id::text, owned, playtime_minutes, verified, updated_at
+        `, ev.UserID, ev.GameID, minPlaytimeMinutes).Scan(
+			&pg.UserID, &pg.GameID, &pg.Owned, &pg.PlaytimeMinutes, &pg.Verified, &pg.UpdatedAt)
+	})
+	if err != nil {
+		logger.Error("apply failed", zap.Error(err), zap.String("type", string(ev.Type)))
+		return entity.PlayerGame{}, mapPgError(err)
+	}
+
+	return pg, nil
+}
+
+// ReverifyRepo пересчитывает verified всех пар по новому порогу и возвращает, у скольких
+// он изменился; агрегаты затронутых игр поправит триггер.
+func (r *RatingRepository) ReverifyRepo(ctx context.Context, minPlaytimeMinutes int64) (_ int64, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ReverifyRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ReverifyRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ReverifyRepo"))
+
+	tag, err := r.pg.Pool.Exec(ctx, `
+      UPDATE player_games
+      SET verified = owned AND playtime_minutes >= $1
+      WHERE verified IS DISTINCT FROM (owned AND playtime_minutes >= $1)
+    `, minPlaytimeMinutes)
+	if err != nil {
+		logger.Error("reverify failed", zap.Error(err))
+		return 0, mapPgError(err)
+	}
+
+	return tag.RowsAffected(), nil
+}
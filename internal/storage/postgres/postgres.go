@@ -3,59 +3,108 @@ package postgres_storage
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/metrics"
 	"github.com/RozmiDan/gameReviewHubRating/pkg/postgres"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
 	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 )
 
+var tracer = otel.Tracer("github.com/RozmiDan/gameReviewHubRating/internal/storage/postgres")
+
+// сколько раз повторяем транзакцию при serialization failure / deadlock
+const _maxTxAttempts = 3
+
 type RatingRepository struct {
-	pg     *postgres.Postgres
-	logger *zap.Logger
+	pg      *postgres.Postgres
+	metrics *metrics.Metrics
+	logger  *zap.Logger
 }
 
-func New(pg *postgres.Postgres, logger *zap.Logger) *RatingRepository {
+func New(pg *postgres.Postgres, m *metrics.Metrics, logger *zap.Logger) *RatingRepository {
 	logger = logger.With(zap.String("layer", "RatingRepository"))
-	return &RatingRepository{pg, logger}
+	return &RatingRepository{pg, m, logger}
 }
 
-func (r *RatingRepository) SubmitRatingRepo(ctx context.Context, userID string, gameID string, rating int32) error {
-	logger := r.logger.With(zap.String("func", "SubmitRatingRepo"))
+func (r *RatingRepository) SubmitRatingRepo(ctx context.Context, rating entity.Rating) (err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.SubmitRatingRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("SubmitRatingRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "SubmitRatingRepo"))
+
+	for attempt := 1; ; attempt++ {
+		err = r.submitRatingTx(ctx, logger, rating)
+		if err == nil || attempt == _maxTxAttempts || !errors.Is(err, entity.ErrConflict) {
+			return err
+		}
+		r.metrics.TxRetries.WithLabelValues("SubmitRatingRepo").Inc()
+		logger.Warn("retrying rating transaction", zap.Int("attempt", attempt), zap.Error(err))
+	}
+}
+
+func (r *RatingRepository) submitRatingTx(ctx context.Context, logger *zap.Logger, rating entity.Rating) error {
 
 	tx, err := r.pg.Pool.Begin(ctx)
 	if err != nil {
 		logger.Error("Begin tx failded", zap.Error(err))
+		return mapPgError(err)
 	}
 	defer tx.Rollback(ctx)
 
-	var oldRating int32
+	// оценку скрыл модератор: пользователь может её поменять, но в агрегаты она не вернётся
+	tag, err := tx.Exec(ctx, `
+        UPDATE hidden_ratings
+        SET rating = $3, scale = $4, score = $5
+        WHERE user_id = $1 AND game_id = $2
+    `, rating.UserID, rating.GameID, rating.Value, string(rating.Scale), rating.Score)
+	if err != nil {
+		logger.Error("update hidden rating failed", zap.Error(err))
+		return mapPgError(err)
+	}
+	if tag.RowsAffected() > 0 {
+		if err := tx.Commit(ctx); err != nil {
+			logger.Error("commit tx failed", zap.Error(err))
+			return mapPgError(err)
+		}
+		logger.Info("rating is hidden by moderation, aggregates unchanged",
+			zap.String("game_id", rating.GameID),
+			zap.String("user_id", rating.UserID),
+		)
+		return nil
+	}
+
+	var oldScore float64
 	isNew := false
 
 	err = tx.QueryRow(ctx,
-		`SELECT rating FROM ratings WHERE user_id=$1 AND game_id=$2`,
-		userID, gameID,
-	).Scan(&oldRating)
+		`SELECT score FROM ratings WHERE user_id=$1 AND game_id=$2`,
+		rating.UserID, rating.GameID,
+	).Scan(&oldScore)
 
 	if err != nil {
-		if err := pgx.ErrNoRows; err == pgx.ErrNoRows {
+		if errors.Is(err, pgx.ErrNoRows) {
 			isNew = true
 		} else {
 			logger.Error("failed to select old rating", zap.Error(err))
-			return err
+			return mapPgError(err)
 		}
 	}
 
 	_, err = tx.Exec(ctx, `
-        INSERT INTO ratings(user_id, game_id, rating)
-        VALUES($1, $2, $3)
+        INSERT INTO ratings(user_id, game_id, rating, scale, score)
+        VALUES($1, $2, $3, $4, $5)
         ON CONFLICT (user_id, game_id)
-        DO UPDATE SET rating = EXCLUDED.rating
-    `, userID, gameID, rating)
+        DO UPDATE SET rating = EXCLUDED.rating, scale = EXCLUDED.scale, score = EXCLUDED.score
+    `, rating.UserID, rating.GameID, rating.Value, string(rating.Scale), rating.Score)
 
 	if err != nil {
 		logger.Error("upsert ratings failed", zap.Error(err))
-		return err
+		return mapPgError(err)
 	}
 
 	if isNew {
@@ -66,65 +115,91 @@ func (r *RatingRepository) SubmitRatingRepo(ctx context.Context, userID string,
               SET
                 ratings_count = game_ratings.ratings_count + 1,
                 ratings_sum   = game_ratings.ratings_sum + EXCLUDED.ratings_sum
-        `, gameID, rating)
+        `, rating.GameID, rating.Score)
 	} else {
-		delta := rating - oldRating
+		delta := rating.Score - oldScore
 		_, err = tx.Exec(ctx, `
             UPDATE game_ratings
             SET ratings_sum = ratings_sum + $1
             WHERE game_id = $2
-        `, delta, gameID)
+        `, delta, rating.GameID)
 	}
 
 	if err != nil {
 		logger.Error("upsert game_ratings failed", zap.Error(err))
-		return err
+		return mapPgError(err)
 	}
 
 	_, err = tx.Exec(ctx, `
         UPDATE game_ratings
         SET average_rating = ROUND(ratings_sum::numeric / ratings_count, 2)
         WHERE game_id = $1
-    `, gameID)
+    `, rating.GameID)
 
 	if err != nil {
 		logger.Error("recalculate average failed", zap.Error(err))
-		return err
+		return mapPgError(err)
 	}
 
 	if err := tx.Commit(ctx); err != nil {
 		logger.Error("commit tx failed", zap.Error(err))
-		return err
+		return mapPgError(err)
 	}
 
+	r.metrics.ObserveWrite(isNew, rating.Score)
+
 	logger.Info("rating successfully updated",
-		zap.String("game_id", gameID),
-		zap.String("user_id", userID),
-		zap.Int32("new_rating", rating),
-		zap.Int32("old_rating", oldRating),
+		zap.String("game_id", rating.GameID),
+		zap.String("user_id", rating.UserID),
+		zap.Int32("value", rating.Value),
+		zap.String("scale", string(rating.Scale)),
+		zap.Float64("new_score", rating.Score),
+		zap.Float64("old_score", oldScore),
 		zap.Bool("new_record", isNew),
 	)
 
 	return nil
 }
 
-func (r *RatingRepository) GetGameRatingRepo(ctx context.Context, gameID string) (entity.GameRating, error) {
-	logger := r.logger.With(zap.String("func", "GetGameRatingRepo"))
+// publicRatingsSQL - рейтинги игр в том виде, в каком их видят клиенты: у замороженных
+// игр значения из снимка game_freezes, у остальных - текущие.
+const publicRatingsSQL = `
+    SELECT g.game_id,
+           CASE WHEN f.game_id IS NULL THEN g.average_rating ELSE COALESCE(f.average_rating, 0) END AS average_rating,
+           CASE WHEN f.game_id IS NULL THEN g.ratings_count ELSE f.ratings_count END AS ratings_count,
+           CASE WHEN f.game_id IS NULL THEN n.normalized_rating ELSE f.normalized_rating END AS normalized_rating,
+           CASE WHEN f.game_id IS NULL THEN g.weighted_average ELSE f.weighted_average END AS weighted_average,
+           CASE WHEN f.game_id IS NULL THEN g.verified_average ELSE f.verified_average END AS verified_average,
+           CASE WHEN f.game_id IS NULL THEN g.verified_count ELSE f.verified_count END AS verified_count,
+           f.game_id IS NOT NULL AS frozen
+    FROM game_ratings g
+    LEFT JOIN game_normalized_ratings n USING (game_id)
+    LEFT JOIN game_freezes f ON f.game_id = g.game_id AND (f.expires_at IS NULL OR f.expires_at > now())
+`
+
+func (r *RatingRepository) GetGameRatingRepo(ctx context.Context, gameID string) (_ entity.GameRating, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.GetGameRatingRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("GetGameRatingRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "GetGameRatingRepo"))
 
 	row := r.pg.Pool.QueryRow(ctx, `
-      SELECT game_id, average_rating, ratings_count
-      FROM game_ratings
+      SELECT game_id, average_rating, ratings_count, normalized_rating, weighted_average,
+             verified_average, verified_count, frozen
+      FROM (`+publicRatingsSQL+`) p
       WHERE game_id = $1
     `, gameID)
 
 	var gameRat entity.GameRating
-	if err := row.Scan(&gameRat.GameId, &gameRat.AverageRating, &gameRat.RatingsCount); err != nil {
+	if err := row.Scan(&gameRat.GameId, &gameRat.AverageRating, &gameRat.RatingsCount, &gameRat.NormalizedRating,
+		&gameRat.WeightedRating, &gameRat.VerifiedRating, &gameRat.VerifiedCount, &gameRat.Frozen); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			logger.Info("gameID not found")
 			return entity.GameRating{}, entity.ErrGameNotFound
 		}
 		logger.Error("scan failed", zap.Error(err))
-		return entity.GameRating{}, err
+		return entity.GameRating{}, mapPgError(err)
 	}
 
 	logger.Info("game successfuly found",
@@ -134,31 +209,94 @@ func (r *RatingRepository) GetGameRatingRepo(ctx context.Context, gameID string)
 	return gameRat, nil
 }
 
-func (r *RatingRepository) GetTopGamesRepo(ctx context.Context, limit, offset int32) ([]entity.GameRating, error) {
-	logger := r.logger.With(zap.String("func", "GetTopGamesRepo"))
+// topLiveSQL и topFrozenSQL - publicRatingsSQL, разделённый на незамороженные и
+// замороженные игры. Первых много, и сортировка по колонкам game_ratings без CASE
+// позволяет идти по индексу game_ratings_rank_idx (или индексу нужного рейтинга) и
+// останавливаться на LIMIT; вторых единицы.
+const (
+	topLiveSQL = `
+    SELECT g.game_id, g.average_rating, g.ratings_count, n.normalized_rating, g.weighted_average,
+           g.verified_average, g.verified_count, false AS frozen
+    FROM game_ratings g
+    LEFT JOIN game_normalized_ratings n USING (game_id)
+    WHERE NOT EXISTS (
+      SELECT 1 FROM game_freezes f
+      WHERE f.game_id = g.game_id AND (f.expires_at IS NULL OR f.expires_at > now())
+    )
+`
+	topFrozenSQL = `
+    SELECT f.game_id, COALESCE(f.average_rating, 0) AS average_rating, f.ratings_count, f.normalized_rating,
+           f.weighted_average, f.verified_average, f.verified_count, true AS frozen
+    FROM game_freezes f
+    JOIN game_ratings g ON g.game_id = f.game_id
+    WHERE (f.expires_at IS NULL OR f.expires_at > now())
+`
+)
+
+// GetTopGamesRepo - страница топа; замороженные игры стоят в нём по снимку. При сортировке
+// по нормализованному, взвешенному или проверенному рейтингу игры без него идут в конце
+// по обычному среднему. Непустой filter оставляет только игры из каталога с подходящими
+// метаданными (см. topFilterSQL).
+func (r *RatingRepository) GetTopGamesRepo(ctx context.Context, limit, offset int32, order entity.TopOrder,
+	filter entity.TopFilter) (_ []entity.GameRating, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.GetTopGamesRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("GetTopGamesRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "GetTopGamesRepo"))
+
+	orderBy := "average_rating DESC NULLS LAST, game_id"
+	switch order {
+	case entity.TopOrderNormalized:
+		orderBy = "normalized_rating DESC NULLS LAST, average_rating DESC NULLS LAST, game_id"
+	case entity.TopOrderWeighted:
+		orderBy = "weighted_average DESC NULLS LAST, average_rating DESC NULLS LAST, game_id"
+	case entity.TopOrderVerified:
+		orderBy = "verified_average DESC NULLS LAST, verified_count DESC, average_rating DESC NULLS LAST, game_id"
+	}
 
+	liveWhere, frozenWhere := "", ""
+	args := []any{limit, offset * limit}
+	if !filter.IsZero() {
+		cond, filterArgs := topFilterSQL(filter, len(args)+1)
+		liveWhere = " AND EXISTS (SELECT 1 FROM game_catalog c WHERE c.game_id = g.game_id AND " + cond + ")"
+		frozenWhere = " AND EXISTS (SELECT 1 FROM game_catalog c WHERE c.game_id = f.game_id AND " + cond + ")"
+		args = append(args, filterArgs...)
+	}
+
+	// из незамороженных на страницу попадут не больше offset+limit первых
 	rows, err := r.pg.Pool.Query(ctx, `
-      SELECT game_id, average_rating, ratings_count
-      FROM game_ratings
-      ORDER BY average_rating DESC
+      SELECT game_id, average_rating, ratings_count, normalized_rating, weighted_average,
+             verified_average, verified_count, frozen
+      FROM (
+        (`+topLiveSQL+liveWhere+` ORDER BY `+orderBy+` LIMIT $1 + $2)
+        UNION ALL
+        (`+topFrozenSQL+frozenWhere+`)
+      ) p
+      ORDER BY `+orderBy+`
       LIMIT $1 OFFSET $2
-    `, limit, offset*limit)
+    `, args...)
 
 	if err != nil {
 		logger.Error("query failed", zap.Error(err))
-		return nil, err
+		return nil, mapPgError(err)
 	}
 	defer rows.Close()
 
 	var out []entity.GameRating
 	for rows.Next() {
 		var gr entity.GameRating
-		if err := rows.Scan(&gr.GameId, &gr.AverageRating, &gr.RatingsCount); err != nil {
+		if err := rows.Scan(&gr.GameId, &gr.AverageRating, &gr.RatingsCount, &gr.NormalizedRating, &gr.WeightedRating,
+			&gr.VerifiedRating, &gr.VerifiedCount, &gr.Frozen); err != nil {
 			logger.Error("scan failed", zap.Error(err))
-			return nil, err
+			return nil, mapPgError(err)
 		}
 		out = append(out, gr)
 	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iteration failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
 
 	logger.Info("games successfuly found", zap.Int("count", len(out)))
 
@@ -0,0 +1,114 @@
+package postgres_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// RecommendCFRepo предсказывает оценки пользователя для соседей игр, которые он оценил:
+// средняя пользователя плюс взвешенное похожестью отклонение его оценок этих игр от неё.
+// Кандидаты с меньше чем minNeighbors оценёнными соседями отбрасываются.
+func (r *RatingRepository) RecommendCFRepo(ctx context.Context, userID string, limit int, excludeRated bool,
+	minNeighbors int) (_ []entity.Recommendation, err error) {
+
+	ctx, span := tracer.Start(ctx, "RatingRepository.RecommendCFRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("RecommendCFRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "RecommendCFRepo"))
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      WITH mine AS (
+        SELECT game_id, score::float8 AS score
+        FROM ratings
+        WHERE user_id = $1
+      ), mu AS (
+        SELECT AVG(score) AS mu FROM mine
+      )
+      SELECT s.neighbor_id::text,
+             mu.mu + SUM(s.similarity * (m.score - mu.mu)) / SUM(s.similarity) AS predicted,
+             COUNT(*) AS support
+      FROM mine m
+      JOIN game_similarities s ON s.game_id = m.game_id
+      CROSS JOIN mu
+      WHERE NOT $2 OR s.neighbor_id NOT IN (SELECT game_id FROM mine)
+      GROUP BY s.neighbor_id, mu.mu
+      HAVING COUNT(*) >= $3
+      ORDER BY predicted DESC, support DESC
+      LIMIT $4
+    `, userID, excludeRated, minNeighbors, limit)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	recs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.Recommendation, error) {
+		rec := entity.Recommendation{Source: entity.SourceItemCF}
+		err := row.Scan(&rec.GameID, &rec.PredictedScore, &rec.Support)
+		return rec, err
+	})
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	return recs, nil
+}
+
+// PopularGamesRepo - игры по убыванию сглаженного среднего: к оценкам игры добавляется
+// prior оценок со средним по всем играм, так что пара десяток не обгоняет сотни девяток.
+// skip - уже выбранные игры; excludeUser != "" - убрать игры, оценённые этим пользователем.
+func (r *RatingRepository) PopularGamesRepo(ctx context.Context, limit int, prior float64, skip []string,
+	excludeUser string) (_ []entity.Recommendation, err error) {
+
+	ctx, span := tracer.Start(ctx, "RatingRepository.PopularGamesRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("PopularGamesRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "PopularGamesRepo"))
+
+	var user any
+	if excludeUser != "" {
+		user = excludeUser
+	}
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      WITH g AS (
+        SELECT COALESCE(SUM(ratings_sum) / NULLIF(SUM(ratings_count), 0), 0)::float8 AS mean
+        FROM game_ratings
+      )
+      SELECT gr.game_id::text,
+             (gr.ratings_sum::float8 + $2::float8 * g.mean) / (gr.ratings_count + $2::float8) AS score,
+             gr.ratings_count
+      FROM game_ratings gr
+      CROSS JOIN g
+      WHERE gr.ratings_count > 0
+        AND gr.game_id <> ALL($3::text[]::uuid[])
+        AND ($4::uuid IS NULL OR NOT EXISTS (
+          SELECT 1 FROM ratings r WHERE r.user_id = $4::uuid AND r.game_id = gr.game_id
+        ))
+      ORDER BY score DESC, gr.ratings_count DESC
+      LIMIT $1
+    `, limit, prior, gameIDs(skip), user)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	recs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.Recommendation, error) {
+		rec := entity.Recommendation{Source: entity.SourcePopularity}
+		err := row.Scan(&rec.GameID, &rec.PredictedScore, &rec.Support)
+		return rec, err
+	})
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	return recs, nil
+}
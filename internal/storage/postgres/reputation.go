@@ -0,0 +1,187 @@
+package postgres_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// ApplyUserEventRepo переносит событие аккаунта в user_profiles. Повтор события безопасен
+// для registered и unflagged; повторный flagged увеличит flag_count ещё раз.
+func (r *RatingRepository) ApplyUserEventRepo(ctx context.Context, ev entity.UserEvent) (err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.ApplyUserEventRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ApplyUserEventRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ApplyUserEventRepo"))
+
+	var query string
+	switch ev.Type {
+	case entity.UserRegistered:
+		// при переотправке оставляем самую раннюю дату регистрации
+		query = `
+          INSERT INTO user_profiles(user_id, registered_at, updated_at)
+          VALUES ($1, $2, now())
+          ON CONFLICT (user_id) DO UPDATE
+            SET registered_at = LEAST(user_profiles.registered_at, EXCLUDED.registered_at),
+                updated_at    = now()
+        `
+	case entity.UserFlagged:
+		query = `
+          INSERT INTO user_profiles(user_id, flagged, flag_count, flag_reason, updated_at)
+          VALUES ($1, true, 1, NULLIF($3, ''), now())
+          ON CONFLICT (user_id) DO UPDATE
+            SET flagged     = true,
+                flag_count  = user_profiles.flag_count + 1,
+                flag_reason = EXCLUDED.flag_reason,
+                updated_at  = now()
+        `
+	case entity.UserUnflagged:
+		query = `
+          INSERT INTO user_profiles(user_id, updated_at)
+          VALUES ($1, now())
+          ON CONFLICT (user_id) DO UPDATE
+            SET flagged = false, updated_at = now()
+        `
+	default:
+		return entity.ErrInvalidArgument
+	}
+
+	if _, err := r.pg.Pool.Exec(ctx, query, ev.UserID, ev.OccurredAt, ev.Reason); err != nil {
+		logger.Error("apply failed", zap.Error(err), zap.String("type", string(ev.Type)))
+		return mapPgError(err)
+	}
+
+	return nil
+}
+
+// newRaterWeightsSQL - факторы и вес каждого оценщика (см. entity.ReputationParams).
+const newRaterWeightsSQL = `
+    INSERT INTO new_rater_weights(user_id, weight, age_factor, history_factor, agreement_factor)
+    WITH game_avg AS (
+      SELECT game_id, AVG(score) AS avg
+      FROM ratings
+      GROUP BY game_id
+      HAVING COUNT(*) >= $3
+    ), raters AS (
+      SELECT r.user_id, COUNT(*) AS n, MIN(r.created_at) AS first_at,
+             AVG(ABS(r.score - g.avg)) FILTER (WHERE g.game_id IS NOT NULL)::float8 AS mad
+      FROM ratings r
+      LEFT JOIN game_avg g ON g.game_id = r.game_id
+      GROUP BY r.user_id
+    ), factors AS (
+      SELECT ra.user_id,
+             LEAST(1, GREATEST(0,
+               EXTRACT(EPOCH FROM now() - COALESCE(p.registered_at, ra.first_at)) / $1::float8)) AS age_factor,
+             LEAST(1, LN(1 + ra.n) / LN(1 + $2::float8)) AS history_factor,
+             COALESCE(1 - LEAST(1, ra.mad / $4::float8), 0.5) AS agreement_factor,
+             CASE WHEN p.flagged THEN $6::float8
+                  WHEN COALESCE(p.flag_count, 0) > 0 THEN $7::float8
+                  ELSE 1 END AS penalty
+      FROM raters ra
+      LEFT JOIN user_profiles p ON p.user_id = ra.user_id
+    )
+    SELECT user_id,
+           GREATEST($5::float8, (age_factor + history_factor + agreement_factor) / 3) * penalty,
+           age_factor, history_factor, agreement_factor
+    FROM factors
+`
+
+// RecalculateWeightsRepo пересчитывает веса всех оценщиков и возвращает, у скольких вес
+// изменился больше чем на ChangeThreshold и сколько игр из-за этого пересчитано. Веса
+// ниже порога не переписываются, чтобы не трогать агрегаты ради дрожания в третьем знаке.
+func (r *RatingRepository) RecalculateWeightsRepo(ctx context.Context, params entity.ReputationParams) (_ entity.ReweightStats, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.RecalculateWeightsRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("RecalculateWeightsRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "RecalculateWeightsRepo"))
+
+	var stats entity.ReweightStats
+	err = pgx.BeginTxFunc(ctx, r.pg.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `
+          CREATE TEMP TABLE new_rater_weights (
+            user_id          UUID             PRIMARY KEY,
+            weight           DOUBLE PRECISION NOT NULL,
+            age_factor       DOUBLE PRECISION NOT NULL,
+            history_factor   DOUBLE PRECISION NOT NULL,
+            agreement_factor DOUBLE PRECISION NOT NULL
+          ) ON COMMIT DROP
+        `); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, newRaterWeightsSQL,
+			params.AgeFull.Seconds(), params.HistoryFull, params.MinConsensusRatings, params.AgreementScale,
+			params.MinWeight, params.FlaggedFactor, params.PastFlagFactor,
+		); err != nil {
+			return err
+		}
+
+		// вес нового пользователя - как у оценщика без истории, возраста и консенсуса
+		if _, err := tx.Exec(ctx, `
+          UPDATE rater_weight_default
+          SET weight = GREATEST($1::float8, 0.5 / 3)
+        `, params.MinWeight); err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, `
+          INSERT INTO rater_weights AS w (user_id, weight, age_factor, history_factor, agreement_factor, computed_at)
+          SELECT user_id, weight, age_factor, history_factor, agreement_factor, now()
+          FROM new_rater_weights
+          ON CONFLICT (user_id) DO UPDATE
+            SET weight           = EXCLUDED.weight,
+                age_factor       = EXCLUDED.age_factor,
+                history_factor   = EXCLUDED.history_factor,
+                agreement_factor = EXCLUDED.agreement_factor,
+                computed_at      = now()
+            WHERE ABS(w.weight - EXCLUDED.weight) > $1
+          RETURNING user_id::text
+        `, params.ChangeThreshold)
+		if err != nil {
+			return err
+		}
+		changed, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
+		stats.Users = int64(len(changed))
+		if len(changed) == 0 {
+			return nil
+		}
+
+		tag, err := tx.Exec(ctx, `
+          WITH games AS (
+            SELECT DISTINCT game_id FROM ratings WHERE user_id = ANY($1::text[]::uuid[])
+          ), agg AS (
+            SELECT r.game_id,
+                   ROUND(SUM(rater_weight(r.user_id) * r.score), 4) AS wsum,
+                   ROUND(SUM(rater_weight(r.user_id)), 4) AS wtotal
+            FROM ratings r
+            JOIN games USING (game_id)
+            GROUP BY r.game_id
+          )
+          UPDATE game_ratings g
+          SET weighted_sum     = agg.wsum,
+              weight_total     = agg.wtotal,
+              weighted_average = ROUND(agg.wsum / NULLIF(agg.wtotal, 0), 2)
+          FROM agg
+          WHERE g.game_id = agg.game_id
+        `, changed)
+		if err != nil {
+			return err
+		}
+		stats.Games = tag.RowsAffected()
+		return nil
+	})
+	if err != nil {
+		logger.Error("recalculate failed", zap.Error(err))
+		return entity.ReweightStats{}, mapPgError(err)
+	}
+
+	return stats, nil
+}
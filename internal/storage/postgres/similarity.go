@@ -0,0 +1,218 @@
+package postgres_storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// similarityPairsSQL считает похожесть игр $1 (NULL - всех) со всеми остальными по общим
+// оценщикам. Отклонения от среднего - по пользователю (adjusted cosine) или по игре
+// (pearson); в знаменателе только общие оценщики пары. Отрицательная похожесть
+// соседям не нужна и отбрасывается.
+func similarityPairsSQL(method entity.SimilarityMethod) string {
+	center := "user_id"
+	if method == entity.SimilarityPearson {
+		center = "game_id"
+	}
+	return fmt.Sprintf(`
+    INSERT INTO similarity_pairs(game_id, neighbor_id, similarity, co_raters)
+    WITH dev AS (
+      SELECT user_id, game_id, (score - AVG(score) OVER (PARTITION BY %s))::float8 AS dev
+      FROM ratings
+    )
+    SELECT game_id, neighbor_id, similarity, co_raters
+    FROM (
+      SELECT a.game_id, b.game_id AS neighbor_id, COUNT(*)::int AS co_raters,
+             SUM(a.dev * b.dev) / NULLIF(SQRT(SUM(a.dev * a.dev) * SUM(b.dev * b.dev)), 0) AS similarity
+      FROM dev a
+      JOIN dev b ON b.user_id = a.user_id AND b.game_id <> a.game_id
+      WHERE $1::text[] IS NULL OR a.game_id = ANY($1::text[]::uuid[])
+      GROUP BY a.game_id, b.game_id
+      HAVING COUNT(*) >= $2
+    ) p
+    WHERE similarity > 0
+    `, center)
+}
+
+// RefreshSimilaritiesRepo пересчитывает соседей для не больше batch игр, у которых менялись
+// оценки, и возвращает их число. Пересчитанная игра получает новый top-K целиком, а в списки
+// остальных игр она вставляется со свежей похожестью; списки тех игр только обрезаются до TopK,
+// поэтому выпавший из них сосед вернётся лишь при их собственном пересчёте или RebuildSimilaritiesRepo.
+func (r *RatingRepository) RefreshSimilaritiesRepo(ctx context.Context, params entity.SimilarityParams, batch int) (_ int, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.RefreshSimilaritiesRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("RefreshSimilaritiesRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "RefreshSimilaritiesRepo"))
+
+	var games []string
+	err = pgx.BeginTxFunc(ctx, r.pg.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// отметки снимаются в той же транзакции: если пересчёт упадёт, они вернутся
+		rows, err := tx.Query(ctx, `
+          DELETE FROM game_similarity_dirty
+          WHERE game_id IN (
+            SELECT game_id FROM game_similarity_dirty
+            ORDER BY marked_at
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+          )
+          RETURNING game_id::text
+        `, batch)
+		if err != nil {
+			return err
+		}
+		if games, err = pgx.CollectRows(rows, pgx.RowTo[string]); err != nil {
+			return err
+		}
+		if len(games) == 0 {
+			return nil
+		}
+
+		if err := fillSimilarityPairs(ctx, tx, params, games); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `
+          DELETE FROM game_similarities
+          WHERE game_id = ANY($1::text[]::uuid[]) OR neighbor_id = ANY($1::text[]::uuid[])
+        `, games); err != nil {
+			return err
+		}
+		if err := insertTopSimilarities(ctx, tx, params.TopK); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `
+          INSERT INTO game_similarities(game_id, neighbor_id, similarity, co_raters)
+          SELECT neighbor_id, game_id, similarity, co_raters
+          FROM similarity_pairs
+          WHERE neighbor_id <> ALL($1::text[]::uuid[])
+        `, games); err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+          DELETE FROM game_similarities s
+          USING (
+            SELECT game_id, neighbor_id,
+                   row_number() OVER (PARTITION BY game_id ORDER BY similarity DESC, co_raters DESC) AS rn
+            FROM game_similarities
+            WHERE game_id IN (SELECT DISTINCT neighbor_id FROM similarity_pairs)
+          ) ranked
+          WHERE s.game_id = ranked.game_id AND s.neighbor_id = ranked.neighbor_id AND ranked.rn > $1
+        `, params.TopK)
+		return err
+	})
+	if err != nil {
+		logger.Error("refresh failed", zap.Error(err))
+		return 0, mapPgError(err)
+	}
+
+	return len(games), nil
+}
+
+// RebuildSimilaritiesRepo пересчитывает соседей всех игр с нуля и возвращает число
+// записанных пар. Читатели до коммита видят старые списки.
+func (r *RatingRepository) RebuildSimilaritiesRepo(ctx context.Context, params entity.SimilarityParams) (_ int64, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.RebuildSimilaritiesRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("RebuildSimilaritiesRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "RebuildSimilaritiesRepo"))
+
+	var written int64
+	err = pgx.BeginTxFunc(ctx, r.pg.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM game_similarity_dirty`); err != nil {
+			return err
+		}
+		if err := fillSimilarityPairs(ctx, tx, params, nil); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM game_similarities`); err != nil {
+			return err
+		}
+		if err := insertTopSimilarities(ctx, tx, params.TopK); err != nil {
+			return err
+		}
+		return tx.QueryRow(ctx, `SELECT COUNT(*) FROM game_similarities`).Scan(&written)
+	})
+	if err != nil {
+		logger.Error("rebuild failed", zap.Error(err))
+		return 0, mapPgError(err)
+	}
+
+	logger.Info("similarities rebuilt", zap.Int64("pairs", written))
+
+	return written, nil
+}
+
+// fillSimilarityPairs - временная similarity_pairs с похожестью игр games (nil - всех).
+func fillSimilarityPairs(ctx context.Context, tx pgx.Tx, params entity.SimilarityParams, games []string) error {
+	if _, err := tx.Exec(ctx, `
+      CREATE TEMP TABLE similarity_pairs (
+        game_id     UUID             NOT NULL,
+        neighbor_id UUID             NOT NULL,
+        similarity  DOUBLE PRECISION NOT NULL,
+        co_raters   INT              NOT NULL
+      ) ON COMMIT DROP
+    `); err != nil {
+		return err
+	}
+
+	_, err := tx.Exec(ctx, similarityPairsSQL(params.Method), games, params.MinCoRaters)
+	return err
+}
+
+// insertTopSimilarities переносит из similarity_pairs по topK лучших соседей каждой игры.
+func insertTopSimilarities(ctx context.Context, tx pgx.Tx, topK int) error {
+	_, err := tx.Exec(ctx, `
+      INSERT INTO game_similarities(game_id, neighbor_id, similarity, co_raters)
+      SELECT game_id, neighbor_id, similarity, co_raters
+      FROM (
+        SELECT game_id, neighbor_id, similarity, co_raters,
+               row_number() OVER (PARTITION BY game_id ORDER BY similarity DESC, co_raters DESC) AS rn
+        FROM similarity_pairs
+      ) ranked
+      WHERE rn <= $1
+    `, topK)
+	return err
+}
+
+// GetSimilarGamesRepo - до k ближайших соседей игры, самые похожие первыми.
+func (r *RatingRepository) GetSimilarGamesRepo(ctx context.Context, gameID string, k int) (_ []entity.SimilarGame, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.GetSimilarGamesRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("GetSimilarGamesRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "GetSimilarGamesRepo"))
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      SELECT neighbor_id::text, similarity, co_raters, computed_at
+      FROM game_similarities
+      WHERE game_id = $1
+      ORDER BY similarity DESC, co_raters DESC
+      LIMIT $2
+    `, gameID, k)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	games, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.SimilarGame, error) {
+		var g entity.SimilarGame
+		err := row.Scan(&g.GameID, &g.Similarity, &g.CoRaters, &g.ComputedAt)
+		return g, err
+	})
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	return games, nil
+}
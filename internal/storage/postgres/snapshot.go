@@ -0,0 +1,179 @@
+package postgres_storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// scoreColumns(f) - по выражению на каждое целое значение score от ScoreMin до ScoreMax.
+func scoreColumns(f func(score int) string) string {
+	parts := make([]string, 0, int(entity.ScoreMax))
+	for s := int(entity.ScoreMin); s <= int(entity.ScoreMax); s++ {
+		parts = append(parts, f(s))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// takeSnapshotSQL пишет текущее состояние всех игр в интервал $2; повторный запуск
+// в том же интервале перезаписывает его, так что в итоге остаётся состояние на конец интервала.
+// Агрегаты берутся из game_ratings, чтобы игра, у которой удалили все оценки, получила
+// снимок с нулями, а не застряла на последнем непустом.
+var takeSnapshotSQL = `
+    INSERT INTO game_rating_snapshots(game_id, granularity, bucket_start,
+                                      ratings_count, ratings_sum, average_rating, distribution)
+    SELECT g.game_id, $1, $2, g.ratings_count, g.ratings_sum, g.average_rating,
+           COALESCE(d.distribution, array_fill(0::bigint, ARRAY[` + fmt.Sprint(int(entity.ScoreMax)) + `]))
+    FROM game_ratings g
+    LEFT JOIN (
+      SELECT game_id, ARRAY[` + scoreColumns(func(s int) string {
+	return fmt.Sprintf("COUNT(*) FILTER (WHERE ROUND(score) = %d)", s)
+}) + `]::bigint[] AS distribution
+      FROM ratings
+      GROUP BY game_id
+    ) d USING (game_id)
+    ON CONFLICT (game_id, granularity, bucket_start) DO UPDATE
+      SET ratings_count  = EXCLUDED.ratings_count,
+          ratings_sum    = EXCLUDED.ratings_sum,
+          average_rating = EXCLUDED.average_rating,
+          distribution   = EXCLUDED.distribution,
+          taken_at       = now()
+`
+
+// backfillSnapshotsSQL восстанавливает снимки из ratings.created_at: накопленные
+// счётчики на конец каждого интервала, в котором у игры появлялись оценки.
+// Перезаписанные оценки учитываются с текущим score - истории изменений нет.
+var backfillSnapshotsSQL = `
+    WITH per_bucket AS (
+      SELECT game_id, date_trunc($1::text, created_at, 'UTC') AS bucket,
+             COUNT(*) AS cnt, SUM(score) AS total,
+             ` + scoreColumns(func(s int) string {
+	return fmt.Sprintf("COUNT(*) FILTER (WHERE ROUND(score) = %d) AS d%d", s, s)
+}) + `
+      FROM ratings
+      GROUP BY 1, 2
+    ), cumulative AS (
+      SELECT game_id, bucket,
+             SUM(cnt) OVER w AS cnt,
+             SUM(total) OVER w AS total,
+             ARRAY[` + scoreColumns(func(s int) string {
+	return fmt.Sprintf("SUM(d%d) OVER w", s)
+}) + `]::bigint[] AS distribution
+      FROM per_bucket
+      WINDOW w AS (PARTITION BY game_id ORDER BY bucket)
+    )
+    INSERT INTO game_rating_snapshots(game_id, granularity, bucket_start,
+                                      ratings_count, ratings_sum, average_rating, distribution)
+    SELECT game_id, $1, bucket, cnt, total, ROUND(total / cnt, 2), distribution
+    FROM cumulative
+    ON CONFLICT (game_id, granularity, bucket_start) DO `
+
+// TakeSnapshotsRepo снимает состояние всех игр в интервал, которому принадлежит at.
+func (r *RatingRepository) TakeSnapshotsRepo(ctx context.Context, g entity.Granularity, at time.Time) (_ int64, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.TakeSnapshotsRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("TakeSnapshotsRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "TakeSnapshotsRepo"))
+
+	tag, err := r.pg.Pool.Exec(ctx, takeSnapshotSQL, string(g), g.Truncate(at))
+	if err != nil {
+		logger.Error("snapshot failed", zap.String("granularity", string(g)), zap.Error(err))
+		return 0, mapPgError(err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// BackfillSnapshotsRepo строит снимки из истории; replace - перезаписать уже снятые.
+func (r *RatingRepository) BackfillSnapshotsRepo(ctx context.Context, g entity.Granularity, replace bool) (_ int64, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.BackfillSnapshotsRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("BackfillSnapshotsRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "BackfillSnapshotsRepo"))
+
+	conflict := "NOTHING"
+	if replace {
+		conflict = `UPDATE
+      SET ratings_count  = EXCLUDED.ratings_count,
+          ratings_sum    = EXCLUDED.ratings_sum,
+          average_rating = EXCLUDED.average_rating,
+          distribution   = EXCLUDED.distribution,
+          taken_at       = now()`
+	}
+
+	tag, err := r.pg.Pool.Exec(ctx, backfillSnapshotsSQL+conflict, string(g))
+	if err != nil {
+		logger.Error("backfill failed", zap.String("granularity", string(g)), zap.Error(err))
+		return 0, mapPgError(err)
+	}
+
+	logger.Info("snapshots backfilled", zap.String("granularity", string(g)), zap.Int64("rows", tag.RowsAffected()))
+
+	return tag.RowsAffected(), nil
+}
+
+// PruneSnapshotsRepo удаляет снимки старше before.
+func (r *RatingRepository) PruneSnapshotsRepo(ctx context.Context, g entity.Granularity, before time.Time) (_ int64, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.PruneSnapshotsRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("PruneSnapshotsRepo", time.Now(), &err)
+
+	tag, err := r.pg.Pool.Exec(ctx, `
+      DELETE FROM game_rating_snapshots
+      WHERE granularity = $1 AND bucket_start < $2
+    `, string(g), before)
+	if err != nil {
+		return 0, mapPgError(err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// GetTimelineRepo возвращает по точке на каждый интервал из buckets: последний снимок,
+// сделанный не позже начала интервала. Интервалы до первого снимка игры пропускаются.
+func (r *RatingRepository) GetTimelineRepo(ctx context.Context, gameID string, g entity.Granularity,
+	buckets []time.Time) (_ []entity.TimelinePoint, err error) {
+
+	ctx, span := tracer.Start(ctx, "RatingRepository.GetTimelineRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("GetTimelineRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "GetTimelineRepo"))
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      SELECT b.bucket, s.ratings_count, COALESCE(s.average_rating, 0), s.distribution
+      FROM unnest($3::timestamptz[]) AS b(bucket)
+      JOIN LATERAL (
+        SELECT ratings_count, average_rating, distribution
+        FROM game_rating_snapshots
+        WHERE game_id = $1 AND granularity = $2 AND bucket_start <= b.bucket
+        ORDER BY bucket_start DESC
+        LIMIT 1
+      ) s ON true
+      ORDER BY b.bucket
+    `, gameID, string(g), buckets)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	points, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.TimelinePoint, error) {
+		var p entity.TimelinePoint
+		err := row.Scan(&p.At, &p.RatingsCount, &p.AverageRating, &p.Distribution)
+		return p, err
+	})
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	return points, nil
+}
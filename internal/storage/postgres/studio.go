@@ -0,0 +1,130 @@
+package postgres_storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const studioColumns = `role, name, games_count, ratings_count, COALESCE(average_rating, 0)::float8`
+
+// GetTopStudiosRepo - страница студий роли role по убыванию среднего; студии, у которых
+// меньше minRatings оценок, не попадают в топ.
+func (r *RatingRepository) GetTopStudiosRepo(ctx context.Context, role entity.StudioRole, minRatings int64,
+	limit, offset int32) (_ []entity.StudioRating, err error) {
+
+	ctx, span := tracer.Start(ctx, "RatingRepository.GetTopStudiosRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("GetTopStudiosRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "GetTopStudiosRepo"))
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      SELECT `+studioColumns+`
+      FROM studio_ratings
+      WHERE role = $1 AND ratings_count >= $2
+      ORDER BY average_rating DESC NULLS LAST, ratings_count DESC, studio_key
+      LIMIT $3 OFFSET $4
+    `, string(role), minRatings, limit, offset)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	studios, err := pgx.CollectRows(rows, scanStudio)
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	return studios, nil
+}
+
+// GetStudioRepo - агрегат студии; имя сравнивается без учёта регистра.
+func (r *RatingRepository) GetStudioRepo(ctx context.Context, role entity.StudioRole, name string) (_ entity.StudioRating, err error) {
+	ctx, span := tracer.Start(ctx, "RatingRepository.GetStudioRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("GetStudioRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "GetStudioRepo"))
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      SELECT `+studioColumns+`
+      FROM studio_ratings
+      WHERE role = $1 AND studio_key = lower($2)
+    `, string(role), name)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return entity.StudioRating{}, mapPgError(err)
+	}
+
+	studio, err := pgx.CollectExactlyOneRow(rows, scanStudio)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.StudioRating{}, entity.ErrStudioNotFound
+	}
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return entity.StudioRating{}, mapPgError(err)
+	}
+
+	return studio, nil
+}
+
+// ListStudioGamesRepo - оценённые игры студии по убыванию среднего. Как и в топе,
+// замороженные игры показываются по снимку, хотя в агрегат студии входят текущие оценки.
+func (r *RatingRepository) ListStudioGamesRepo(ctx context.Context, role entity.StudioRole, name string,
+	limit, offset int32) (_ []entity.GameRating, err error) {
+
+	ctx, span := tracer.Start(ctx, "RatingRepository.ListStudioGamesRepo")
+	defer span.End()
+	defer r.metrics.ObserveQuery("ListStudioGamesRepo", time.Now(), &err)
+
+	logger := tracing.Logger(ctx, r.logger).With(zap.String("func", "ListStudioGamesRepo"))
+
+	studioCond := "lower(c.developer) = lower($1)"
+	if role == entity.StudioPublisher {
+		studioCond = "lower(c.publisher) = lower($1)"
+	}
+
+	rows, err := r.pg.Pool.Query(ctx, `
+      SELECT p.game_id, p.average_rating, p.ratings_count, p.normalized_rating, p.weighted_average,
+             p.verified_average, p.verified_count, p.frozen
+      FROM (`+publicRatingsSQL+`) p
+      JOIN game_catalog c ON c.game_id = p.game_id AND NOT c.deleted
+      WHERE `+studioCond+` AND p.ratings_count > 0
+      ORDER BY p.average_rating DESC, p.ratings_count DESC, p.game_id
+      LIMIT $2 OFFSET $3
+    `, name, limit, offset)
+	if err != nil {
+		logger.Error("query failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	games, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.GameRating, error) {
+		var gr entity.GameRating
+		err := row.Scan(&gr.GameId, &gr.AverageRating, &gr.RatingsCount, &gr.NormalizedRating, &gr.WeightedRating,
+			&gr.VerifiedRating, &gr.VerifiedCount, &gr.Frozen)
+		return gr, err
+	})
+	if err != nil {
+		logger.Error("scan failed", zap.Error(err))
+		return nil, mapPgError(err)
+	}
+
+	return games, nil
+}
+
+func scanStudio(row pgx.CollectableRow) (entity.StudioRating, error) {
+	var (
+		s    entity.StudioRating
+		role string
+	)
+	err := row.Scan(&role, &s.Name, &s.GamesCount, &s.RatingsCount, &s.AverageRating)
+	s.Role = entity.StudioRole(role)
+	return s, err
+}
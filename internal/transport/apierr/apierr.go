@@ -0,0 +1,232 @@
+// Package apierr - единое отображение доменных ошибок entity в коды gRPC и HTTP.
+package apierr
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const Domain = "rating.gamehub"
+
+const (
+	ReasonInvalidArgument = "INVALID_ARGUMENT"
+	ReasonGameNotFound    = "GAME_NOT_FOUND"
+	ReasonStudioNotFound  = "STUDIO_NOT_FOUND"
+	ReasonNotExempt       = "USER_NOT_EXEMPT"
+	ReasonConflict        = "CONFLICT"
+	ReasonUnavailable     = "UNAVAILABLE"
+	ReasonRateLimited     = "RATE_LIMITED"
+	ReasonSlowConsumer    = "SLOW_CONSUMER"
+	ReasonNotFrozen       = "GAME_NOT_FROZEN"
+	ReasonNotReleased     = "GAME_NOT_RELEASED"
+	ReasonInternal        = "INTERNAL"
+)
+
+const _defaultRetryAfter = time.Second
+
+type Violation struct {
+	Field       string `json:"field"`
+	Reason      string `json:"reason"`
+	Description string `json:"description"`
+}
+
+// Problem - транспортно-независимое описание ошибки.
+type Problem struct {
+	Code       codes.Code
+	HTTPStatus int
+	Reason     string
+	Message    string
+	Violations []Violation
+	RetryAfter time.Duration
+	Metadata   map[string]string
+}
+
+func Classify(err error) Problem {
+	if err == nil {
+		return Problem{Code: codes.OK, HTTPStatus: http.StatusOK}
+	}
+
+	var (
+		validation *entity.ValidationError
+		field      *entity.FieldError
+		retryable  *entity.RetryableError
+		embargo    *entity.EmbargoError
+	)
+
+	switch {
+	case errors.As(err, &validation):
+		p := Problem{
+			Code:       codes.InvalidArgument,
+			HTTPStatus: http.StatusBadRequest,
+			Reason:     ReasonInvalidArgument,
+			Message:    "invalid request",
+		}
+		for _, f := range validation.Fields {
+			p.Violations = append(p.Violations, violation(f))
+		}
+		return p
+
+	case errors.As(err, &field):
+		return Problem{
+			Code:       codes.InvalidArgument,
+			HTTPStatus: http.StatusBadRequest,
+			Reason:     ReasonInvalidArgument,
+			Message:    "invalid request",
+			Violations: []Violation{violation(field)},
+		}
+
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return Problem{
+			Code:       codes.InvalidArgument,
+			HTTPStatus: http.StatusBadRequest,
+			Reason:     ReasonInvalidArgument,
+			Message:    "invalid request",
+		}
+
+	case errors.Is(err, entity.ErrGameNotFound):
+		return Problem{
+			Code:       codes.NotFound,
+			HTTPStatus: http.StatusNotFound,
+			Reason:     ReasonGameNotFound,
+			Message:    "game not found",
+		}
+
+	case errors.Is(err, entity.ErrStudioNotFound):
+		return Problem{
+			Code:       codes.NotFound,
+			HTTPStatus: http.StatusNotFound,
+			Reason:     ReasonStudioNotFound,
+			Message:    "studio not found",
+		}
+
+	case errors.Is(err, entity.ErrNotExempt):
+		return Problem{
+			Code:       codes.NotFound,
+			HTTPStatus: http.StatusNotFound,
+			Reason:     ReasonNotExempt,
+			Message:    "user is not exempt from embargo",
+		}
+
+	case errors.As(err, &embargo):
+		return Problem{
+			Code:       codes.FailedPrecondition,
+			HTTPStatus: http.StatusUnprocessableEntity,
+			Reason:     ReasonNotReleased,
+			Message:    "game is not released yet",
+			Metadata:   map[string]string{"release_at": embargo.ReleaseAt.UTC().Format(time.RFC3339)},
+		}
+
+	case errors.Is(err, entity.ErrNotFrozen):
+		return Problem{
+			Code:       codes.FailedPrecondition,
+			HTTPStatus: http.StatusConflict,
+			Reason:     ReasonNotFrozen,
+			Message:    "game is not frozen",
+		}
+
+	case errors.Is(err, entity.ErrSlowConsumer):
+		return Problem{
+			Code:       codes.ResourceExhausted,
+			HTTPStatus: http.StatusTooManyRequests,
+			Reason:     ReasonSlowConsumer,
+			Message:    "subscriber did not keep up with updates",
+		}
+
+	case errors.As(err, &retryable):
+		p := retryableProblem(retryable.Err)
+		p.RetryAfter = retryable.RetryAfter
+		if retryable.Reason != "" {
+			p.Metadata = map[string]string{"detail": retryable.Reason}
+		}
+		if p.RetryAfter <= 0 {
+			p.RetryAfter = _defaultRetryAfter
+		}
+		return p
+
+	case errors.Is(err, entity.ErrConflict), errors.Is(err, entity.ErrUnavailable), errors.Is(err, entity.ErrRateLimited):
+		p := retryableProblem(err)
+		p.RetryAfter = _defaultRetryAfter
+		return p
+	}
+
+	return Problem{
+		Code:       codes.Internal,
+		HTTPStatus: http.StatusInternalServerError,
+		Reason:     ReasonInternal,
+		Message:    "internal error",
+	}
+}
+
+func retryableProblem(err error) Problem {
+	switch {
+	case errors.Is(err, entity.ErrConflict):
+		return Problem{Code: codes.Aborted, HTTPStatus: http.StatusConflict,
+			Reason: ReasonConflict, Message: "conflicting concurrent update"}
+	case errors.Is(err, entity.ErrRateLimited):
+		return Problem{Code: codes.ResourceExhausted, HTTPStatus: http.StatusTooManyRequests,
+			Reason: ReasonRateLimited, Message: "rate limit exceeded"}
+	default:
+		return Problem{Code: codes.Unavailable, HTTPStatus: http.StatusServiceUnavailable,
+			Reason: ReasonUnavailable, Message: "service temporarily unavailable"}
+	}
+}
+
+func violation(f *entity.FieldError) Violation {
+	reason := ReasonInvalidArgument
+	switch {
+	case errors.Is(f.Err, entity.ErrInvalidUUID):
+		reason = "INVALID_UUID"
+	case errors.Is(f.Err, entity.ErrInvalidScore):
+		reason = "SCORE_OUT_OF_RANGE"
+	case errors.Is(f.Err, entity.ErrRequired):
+		reason = "REQUIRED"
+	case errors.Is(f.Err, entity.ErrUnknownGame):
+		reason = "UNKNOWN_GAME"
+	}
+	return Violation{Field: f.Field, Reason: reason, Description: f.Description}
+}
+
+// GRPCStatus превращает ошибку в status с ErrorInfo, BadRequest и RetryInfo.
+func GRPCStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	p := Classify(err)
+	st := status.New(p.Code, p.Message)
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{Reason: p.Reason, Domain: Domain, Metadata: p.Metadata},
+	}
+	if len(p.Violations) > 0 {
+		br := &errdetails.BadRequest{}
+		for _, v := range p.Violations {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+				Reason:      v.Reason,
+			})
+		}
+		details = append(details, br)
+	}
+	if p.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(p.RetryAfter)})
+	}
+
+	withDetails, detErr := st.WithDetails(details...)
+	if detErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
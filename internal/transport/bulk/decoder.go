@@ -0,0 +1,291 @@
+package bulk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// _maxLineSize - ограничение на длину одной строки JSON Lines.
+const _maxLineSize = 1 << 20
+
+// _bom - UTF-8 BOM, который добавляют некоторые редакторы и Excel.
+var _bom = []byte("\xef\xbb\xbf")
+
+// Decoder отдаёт записи файла по одной. Ошибка *entity.LineError относится
+// к одной строке - чтение можно продолжать; io.EOF - конец файла;
+// остальные ошибки фатальны.
+type Decoder interface {
+	Next() (entity.ImportRecord, error)
+}
+
+// jsonRecord - запись в JSON и JSON Lines; rating указателем, чтобы отличать отсутствие от нуля.
+type jsonRecord struct {
+	GameID    string     `json:"game_id"`
+	UserID    string     `json:"user_id"`
+	Rating    *int32     `json:"rating"`
+	Scale     string     `json:"scale"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func (j jsonRecord) record(line int) (entity.ImportRecord, error) {
+	if j.Rating == nil {
+		return entity.ImportRecord{}, &entity.LineError{Line: line, Err: &entity.FieldError{
+			Field: "rating", Err: entity.ErrRequired, Description: "rating is required"}}
+	}
+	return entity.ImportRecord{
+		Line:      line,
+		GameID:    j.GameID,
+		UserID:    j.UserID,
+		Rating:    *j.Rating,
+		Scale:     j.Scale,
+		CreatedAt: j.CreatedAt,
+	}, nil
+}
+
+// NewDecoder выбирает декодер по формату. Для FormatAuto и FormatJSON формат
+// определяется по первому значащему символу: '[' - JSON-массив, '{' - JSON Lines, иначе CSV.
+func NewDecoder(r io.Reader, format Format) (Decoder, error) {
+	br := bufio.NewReader(r)
+	if bom, _ := br.Peek(len(_bom)); bytes.Equal(bom, _bom) {
+		_, _ = br.Discard(len(_bom))
+	}
+
+	if format == FormatAuto || format == FormatJSON {
+		switch first, err := peekNonSpace(br); {
+		case err == io.EOF:
+			return emptyDecoder{}, nil
+		case err != nil:
+			return nil, err
+		case first == '[':
+			format = FormatJSON
+		case first == '{':
+			format = FormatJSONL
+		case format == FormatJSON:
+			return nil, fmt.Errorf("json: expected '[' or '{', got %q", first)
+		default:
+			format = FormatCSV
+		}
+	}
+
+	switch format {
+	case FormatJSON:
+		return newJSONDecoder(br)
+	case FormatJSONL:
+		return newJSONLDecoder(br), nil
+	case FormatCSV:
+		return newCSVDecoder(br)
+	default:
+		return nil, fmt.Errorf("unsupported import format %q", format)
+	}
+}
+
+func peekNonSpace(br *bufio.Reader) (byte, error) {
+	for n := 1; ; n++ {
+		buf, err := br.Peek(n)
+		if len(buf) < n {
+			if err == nil {
+				err = io.EOF
+			}
+			return 0, err
+		}
+		if c := buf[n-1]; !isSpace(c) {
+			return c, nil
+		}
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+type emptyDecoder struct{}
+
+func (emptyDecoder) Next() (entity.ImportRecord, error) {
+	return entity.ImportRecord{}, io.EOF
+}
+
+// jsonDecoder читает JSON-массив целиком: номер строки объекта считается по смещению в данных.
+type jsonDecoder struct {
+	data []byte
+	dec  *json.Decoder
+	done bool
+}
+
+func newJSONDecoder(r io.Reader) (*jsonDecoder, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	d := &jsonDecoder{data: data, dec: json.NewDecoder(bytes.NewReader(data))}
+
+	tok, err := d.dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("json: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("json: expected array, got %v", tok)
+	}
+	return d, nil
+}
+
+func (d *jsonDecoder) Next() (entity.ImportRecord, error) {
+	if d.done {
+		return entity.ImportRecord{}, io.EOF
+	}
+	if !d.dec.More() {
+		d.done = true
+		if _, err := d.dec.Token(); err != nil {
+			return entity.ImportRecord{}, fmt.Errorf("json: %w", err)
+		}
+		return entity.ImportRecord{}, io.EOF
+	}
+
+	line := d.lineAt(d.dec.InputOffset())
+
+	var j jsonRecord
+	if err := d.dec.Decode(&j); err != nil {
+		var syntax *json.SyntaxError
+		if errors.As(err, &syntax) || errors.Is(err, io.ErrUnexpectedEOF) {
+			d.done = true
+			return entity.ImportRecord{}, fmt.Errorf("json: line %d: %w", line, err)
+		}
+		return entity.ImportRecord{}, &entity.LineError{Line: line, Err: err}
+	}
+	return j.record(line)
+}
+
+// lineAt - номер строки первого значащего символа после offset (пропуская пробелы и запятую).
+func (d *jsonDecoder) lineAt(offset int64) int {
+	i := int(offset)
+	for i < len(d.data) && (isSpace(d.data[i]) || d.data[i] == ',') {
+		i++
+	}
+	return bytes.Count(d.data[:i], []byte("\n")) + 1
+}
+
+type jsonlDecoder struct {
+	sc   *bufio.Scanner
+	line int
+}
+
+func newJSONLDecoder(r io.Reader) *jsonlDecoder {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), _maxLineSize)
+	return &jsonlDecoder{sc: sc}
+}
+
+func (d *jsonlDecoder) Next() (entity.ImportRecord, error) {
+	for d.sc.Scan() {
+		d.line++
+		text := bytes.TrimSpace(d.sc.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var j jsonRecord
+		if err := json.Unmarshal(text, &j); err != nil {
+			return entity.ImportRecord{}, &entity.LineError{Line: d.line, Err: err}
+		}
+		return j.record(d.line)
+	}
+	if err := d.sc.Err(); err != nil {
+		return entity.ImportRecord{}, fmt.Errorf("jsonl: line %d: %w", d.line+1, err)
+	}
+	return entity.ImportRecord{}, io.EOF
+}
+
+// csvDecoder - CSV с заголовком; обязательные колонки game_id, user_id, rating,
+// необязательные scale и created_at (RFC 3339).
+type csvDecoder struct {
+	r       *csv.Reader
+	columns map[string]int
+}
+
+func newCSVDecoder(r io.Reader) (*csvDecoder, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("csv: missing header")
+		}
+		return nil, fmt.Errorf("csv: header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		columns[name] = i
+	}
+	for _, required := range []string{"game_id", "user_id", "rating"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("csv: header has no %q column", required)
+		}
+	}
+
+	return &csvDecoder{r: cr, columns: columns}, nil
+}
+
+func (d *csvDecoder) Next() (entity.ImportRecord, error) {
+	row, err := d.r.Read()
+	if err != nil {
+		var perr *csv.ParseError
+		if errors.As(err, &perr) {
+			return entity.ImportRecord{}, &entity.LineError{Line: perr.StartLine, Err: perr.Err}
+		}
+		return entity.ImportRecord{}, err
+	}
+
+	line, _ := d.r.FieldPos(0)
+	rec := entity.ImportRecord{
+		Line:   line,
+		GameID: d.field(row, "game_id"),
+		UserID: d.field(row, "user_id"),
+		Scale:  d.field(row, "scale"),
+	}
+
+	verr := &entity.ValidationError{}
+
+	switch raw := d.field(row, "rating"); raw {
+	case "":
+		verr.Add("rating", entity.ErrRequired, "rating is required")
+	default:
+		v, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			verr.Add("rating", entity.ErrInvalidArgument, "rating must be an integer")
+		}
+		rec.Rating = int32(v)
+	}
+
+	if raw := d.field(row, "created_at"); raw != "" {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			verr.Add("created_at", entity.ErrInvalidArgument, "created_at must be an RFC 3339 timestamp")
+		}
+		rec.CreatedAt = &t
+	}
+
+	if err := verr.OrNil(); err != nil {
+		return entity.ImportRecord{}, &entity.LineError{Line: line, Err: err}
+	}
+	return rec, nil
+}
+
+func (d *csvDecoder) field(row []string, name string) string {
+	i, ok := d.columns[name]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
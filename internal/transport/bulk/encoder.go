@@ -0,0 +1,169 @@
+package bulk
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/parquet-go/parquet-go"
+)
+
+// Encoder пишет строки одного набора данных в одном формате.
+// Close дописывает буферы (и футер parquet), но не закрывает исходный io.Writer.
+type Encoder[T any] interface {
+	Write(row T) error
+	Close() error
+}
+
+// ratingRow - колонки выгрузки ratings; JSON Lines совместим с форматом импорта.
+type ratingRow struct {
+	GameID    string    `json:"game_id" parquet:"game_id"`
+	UserID    string    `json:"user_id" parquet:"user_id"`
+	Rating    int32     `json:"rating" parquet:"rating"`
+	Scale     string    `json:"scale" parquet:"scale"`
+	Score     float64   `json:"score" parquet:"score"`
+	CreatedAt time.Time `json:"created_at" parquet:"created_at,timestamp(millisecond)"`
+}
+
+var ratingColumns = []string{"game_id", "user_id", "rating", "scale", "score", "created_at"}
+
+func toRatingRow(r entity.ExportRating) ratingRow {
+	return ratingRow{
+		GameID:    r.GameID,
+		UserID:    r.UserID,
+		Rating:    r.Rating,
+		Scale:     string(r.Scale),
+		Score:     r.Score,
+		CreatedAt: r.CreatedAt.UTC(),
+	}
+}
+
+func (r ratingRow) record() []string {
+	return []string{
+		r.GameID,
+		r.UserID,
+		strconv.FormatInt(int64(r.Rating), 10),
+		r.Scale,
+		formatFloat(r.Score),
+		r.CreatedAt.Format(time.RFC3339Nano),
+	}
+}
+
+type gameRatingRow struct {
+	GameID        string  `json:"game_id" parquet:"game_id"`
+	RatingsCount  int64   `json:"ratings_count" parquet:"ratings_count"`
+	RatingsSum    float64 `json:"ratings_sum" parquet:"ratings_sum"`
+	AverageRating float64 `json:"average_rating" parquet:"average_rating"`
+}
+
+var gameRatingColumns = []string{"game_id", "ratings_count", "ratings_sum", "average_rating"}
+
+func toGameRatingRow(g entity.ExportGameRating) gameRatingRow {
+	return gameRatingRow(g)
+}
+
+func (g gameRatingRow) record() []string {
+	return []string{
+		g.GameID,
+		strconv.FormatInt(g.RatingsCount, 10),
+		formatFloat(g.RatingsSum),
+		formatFloat(g.AverageRating),
+	}
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func NewRatingEncoder(w io.Writer, format Format) (Encoder[entity.ExportRating], error) {
+	return newEncoder(w, format, toRatingRow, ratingColumns, ratingRow.record)
+}
+
+func NewGameRatingEncoder(w io.Writer, format Format) (Encoder[entity.ExportGameRating], error) {
+	return newEncoder(w, format, toGameRatingRow, gameRatingColumns, gameRatingRow.record)
+}
+
+func newEncoder[T, R any](w io.Writer, format Format, conv func(T) R, columns []string, record func(R) []string) (Encoder[T], error) {
+	switch format {
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(columns); err != nil {
+			return nil, err
+		}
+		return &csvEncoder[T, R]{w: cw, conv: conv, record: record}, nil
+	case FormatJSONL:
+		bw := bufio.NewWriter(w)
+		return &jsonlEncoder[T, R]{w: bw, enc: json.NewEncoder(bw), conv: conv}, nil
+	case FormatParquet:
+		return &parquetEncoder[T, R]{w: parquet.NewGenericWriter[R](w), conv: conv}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+type csvEncoder[T, R any] struct {
+	w      *csv.Writer
+	conv   func(T) R
+	record func(R) []string
+}
+
+func (e *csvEncoder[T, R]) Write(row T) error {
+	return e.w.Write(e.record(e.conv(row)))
+}
+
+func (e *csvEncoder[T, R]) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type jsonlEncoder[T, R any] struct {
+	w    *bufio.Writer
+	enc  *json.Encoder
+	conv func(T) R
+}
+
+func (e *jsonlEncoder[T, R]) Write(row T) error {
+	return e.enc.Encode(e.conv(row))
+}
+
+func (e *jsonlEncoder[T, R]) Close() error {
+	return e.w.Flush()
+}
+
+// _parquetBatch - сколько строк копим перед передачей в parquet.GenericWriter.
+const _parquetBatch = 1024
+
+type parquetEncoder[T, R any] struct {
+	w    *parquet.GenericWriter[R]
+	conv func(T) R
+	buf  []R
+}
+
+func (e *parquetEncoder[T, R]) Write(row T) error {
+	e.buf = append(e.buf, e.conv(row))
+	if len(e.buf) < _parquetBatch {
+		return nil
+	}
+	return e.flush()
+}
+
+func (e *parquetEncoder[T, R]) flush() error {
+	if len(e.buf) == 0 {
+		return nil
+	}
+	_, err := e.w.Write(e.buf)
+	e.buf = e.buf[:0]
+	return err
+}
+
+func (e *parquetEncoder[T, R]) Close() error {
+	if err := e.flush(); err != nil {
+		return err
+	}
+	return e.w.Close()
+}
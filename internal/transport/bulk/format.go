@@ -0,0 +1,56 @@
+// Package bulk - файловые форматы массовой загрузки оценок.
+package bulk
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatAuto  Format = ""
+	FormatJSON  Format = "json"
+	FormatJSONL Format = "jsonl"
+	FormatCSV   Format = "csv"
+	// FormatParquet - только для экспорта
+	FormatParquet Format = "parquet"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatAuto, "auto":
+		return FormatAuto, nil
+	case FormatJSON, FormatJSONL, FormatCSV, FormatParquet:
+		return f, nil
+	case "ndjson":
+		return FormatJSONL, nil
+	default:
+		return "", fmt.Errorf("unknown format %q", s)
+	}
+}
+
+// FormatFromPath угадывает формат по расширению файла; FormatAuto, если не удалось.
+func FormatFromPath(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON
+	case ".jsonl", ".ndjson":
+		return FormatJSONL
+	case ".csv":
+		return FormatCSV
+	case ".parquet":
+		return FormatParquet
+	default:
+		return FormatAuto
+	}
+}
+
+// Ext - расширение файла для формата.
+func (f Format) Ext() string {
+	if f == FormatAuto {
+		return ""
+	}
+	return "." + string(f)
+}
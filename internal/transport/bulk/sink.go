@@ -0,0 +1,82 @@
+package bulk
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// Sink пишет наборы данных выгрузки в отдельные io.WriteCloser, которые открывает open.
+// Реализует usecase.ExportSink.
+type Sink struct {
+	format Format
+	open   func(ds entity.Dataset) (io.WriteCloser, error)
+
+	w       io.WriteCloser
+	ratings Encoder[entity.ExportRating]
+	games   Encoder[entity.ExportGameRating]
+}
+
+func NewSink(format Format, open func(ds entity.Dataset) (io.WriteCloser, error)) *Sink {
+	return &Sink{format: format, open: open}
+}
+
+func (s *Sink) Begin(ds entity.Dataset) error {
+	w, err := s.open(ds)
+	if err != nil {
+		return err
+	}
+
+	switch ds {
+	case entity.DatasetRatings:
+		s.ratings, err = NewRatingEncoder(w, s.format)
+	case entity.DatasetGameRatings:
+		s.games, err = NewGameRatingEncoder(w, s.format)
+	default:
+		err = fmt.Errorf("unknown dataset %q", ds)
+	}
+	if err != nil {
+		return errors.Join(err, w.Close())
+	}
+
+	s.w = w
+	return nil
+}
+
+func (s *Sink) WriteRating(r entity.ExportRating) error {
+	if s.ratings == nil {
+		return errors.New("bulk: ratings dataset is not open")
+	}
+	return s.ratings.Write(r)
+}
+
+func (s *Sink) WriteGameRating(g entity.ExportGameRating) error {
+	if s.games == nil {
+		return errors.New("bulk: game_ratings dataset is not open")
+	}
+	return s.games.Write(g)
+}
+
+func (s *Sink) End(ds entity.Dataset) error {
+	var err error
+	switch ds {
+	case entity.DatasetRatings:
+		if s.ratings != nil {
+			err = s.ratings.Close()
+		}
+		s.ratings = nil
+	case entity.DatasetGameRatings:
+		if s.games != nil {
+			err = s.games.Close()
+		}
+		s.games = nil
+	}
+
+	if s.w != nil {
+		err = errors.Join(err, s.w.Close())
+		s.w = nil
+	}
+	return err
+}
@@ -0,0 +1,295 @@
+package admin_server
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	ratingextv1 "github.com/RozmiDan/gameReviewHubRating/gen/go/ratingext"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ModerationUseCase interface {
+	HideRatings(ctx context.Context, target entity.ModerationTarget, moderator, reason string) (entity.ModerationAction, error)
+	UnhideRatings(ctx context.Context, target entity.ModerationTarget, moderator, reason string) (entity.ModerationAction, error)
+	ListActions(ctx context.Context, f entity.ModerationFilter) ([]entity.ModerationAction, int64, error)
+}
+
+type FreezeUseCase interface {
+	FreezeGame(ctx context.Context, gameID, moderator, reason string, ttl time.Duration) (entity.GameFreeze, error)
+	UnfreezeGame(ctx context.Context, gameID, moderator, reason string) (entity.GameFreeze, error)
+	ListFrozenGames(ctx context.Context) ([]entity.GameFreeze, error)
+}
+
+type EmbargoUseCase interface {
+	SetGameRelease(ctx context.Context, rel entity.GameRelease) (entity.GameRelease, error)
+	DeleteGameRelease(ctx context.Context, gameID string) error
+	ListUpcomingReleases(ctx context.Context) ([]entity.GameRelease, error)
+	GrantEmbargoExemption(ctx context.Context, e entity.EmbargoExemption) (entity.EmbargoExemption, error)
+	RevokeEmbargoExemption(ctx context.Context, userID string) error
+	ListEmbargoExemptions(ctx context.Context) ([]entity.EmbargoExemption, error)
+}
+
+type serverAPI struct {
+	ratingextv1.UnimplementedRatingAdminServiceServer
+	moderation ModerationUseCase
+	freeze     FreezeUseCase
+	embargo    EmbargoUseCase
+}
+
+func Register(grpcServer *grpc.Server, moderation ModerationUseCase, freeze FreezeUseCase, embargo EmbargoUseCase) {
+	ratingextv1.RegisterRatingAdminServiceServer(grpcServer, &serverAPI{
+		moderation: moderation,
+		freeze:     freeze,
+		embargo:    embargo,
+	})
+}
+
+func (s *serverAPI) HideRatings(ctx context.Context,
+	req *ratingextv1.ModerateRatingsRequest) (*ratingextv1.ModerationAction, error) {
+
+	action, err := s.moderation.HideRatings(ctx, fromTarget(req.GetTarget()), req.GetModerator(), req.GetReason())
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+	return toAction(action), nil
+}
+
+func (s *serverAPI) UnhideRatings(ctx context.Context,
+	req *ratingextv1.ModerateRatingsRequest) (*ratingextv1.ModerationAction, error) {
+
+	action, err := s.moderation.UnhideRatings(ctx, fromTarget(req.GetTarget()), req.GetModerator(), req.GetReason())
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+	return toAction(action), nil
+}
+
+func (s *serverAPI) ListModerationActions(ctx context.Context,
+	req *ratingextv1.ListModerationActionsRequest) (*ratingextv1.ListModerationActionsResponse, error) {
+
+	f := entity.ModerationFilter{
+		UserID:    req.GetUserId(),
+		GameID:    req.GetGameId(),
+		Moderator: req.GetModerator(),
+		Limit:     int(req.GetPageSize()),
+	}
+	if token := req.GetPageToken(); token != "" {
+		id, err := strconv.ParseInt(token, 10, 64)
+		if err != nil || id <= 0 {
+			verr := &entity.ValidationError{}
+			verr.Add("page_token", entity.ErrInvalidArgument, "page token is invalid")
+			return nil, apierr.GRPCStatus(verr)
+		}
+		f.BeforeID = id
+	}
+
+	actions, next, err := s.moderation.ListActions(ctx, f)
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	resp := &ratingextv1.ListModerationActionsResponse{
+		Actions: make([]*ratingextv1.ModerationAction, 0, len(actions)),
+	}
+	for _, a := range actions {
+		resp.Actions = append(resp.Actions, toAction(a))
+	}
+	if next > 0 {
+		resp.NextPageToken = strconv.FormatInt(next, 10)
+	}
+
+	return resp, nil
+}
+
+func (s *serverAPI) FreezeGame(ctx context.Context,
+	req *ratingextv1.FreezeGameRequest) (*ratingextv1.GameFreeze, error) {
+
+	var ttl time.Duration
+	if req.GetTtl() != nil {
+		ttl = req.GetTtl().AsDuration()
+	}
+
+	f, err := s.freeze.FreezeGame(ctx, req.GetGameId(), req.GetModerator(), req.GetReason(), ttl)
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+	return toFreeze(f), nil
+}
+
+func (s *serverAPI) UnfreezeGame(ctx context.Context,
+	req *ratingextv1.UnfreezeGameRequest) (*ratingextv1.GameFreeze, error) {
+
+	f, err := s.freeze.UnfreezeGame(ctx, req.GetGameId(), req.GetModerator(), req.GetReason())
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+	return toFreeze(f), nil
+}
+
+func (s *serverAPI) ListFrozenGames(ctx context.Context,
+	_ *ratingextv1.ListFrozenGamesRequest) (*ratingextv1.ListFrozenGamesResponse, error) {
+
+	freezes, err := s.freeze.ListFrozenGames(ctx)
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	resp := &ratingextv1.ListFrozenGamesResponse{Games: make([]*ratingextv1.GameFreeze, 0, len(freezes))}
+	for _, f := range freezes {
+		resp.Games = append(resp.Games, toFreeze(f))
+	}
+	return resp, nil
+}
+
+func toFreeze(f entity.GameFreeze) *ratingextv1.GameFreeze {
+	out := &ratingextv1.GameFreeze{
+		GameId:        f.GameID,
+		FrozenAt:      timestamppb.New(f.FrozenAt),
+		Moderator:     f.Moderator,
+		Reason:        f.Reason,
+		AverageRating: f.Snapshot.AverageRating,
+		RatingsCount:  f.Snapshot.RatingsCount,
+	}
+	if !f.ExpiresAt.IsZero() {
+		out.ExpiresAt = timestamppb.New(f.ExpiresAt)
+	}
+	return out
+}
+
+func (s *serverAPI) SetGameRelease(ctx context.Context,
+	req *ratingextv1.SetGameReleaseRequest) (*ratingextv1.GameRelease, error) {
+
+	rel, err := s.embargo.SetGameRelease(ctx, entity.GameRelease{
+		GameID:    req.GetGameId(),
+		ReleaseAt: timestampOrZero(req.GetReleaseAt()),
+		Policy:    entity.EmbargoPolicy(req.GetPolicy()),
+	})
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+	return toRelease(rel), nil
+}
+
+func (s *serverAPI) DeleteGameRelease(ctx context.Context,
+	req *ratingextv1.DeleteGameReleaseRequest) (*ratingextv1.DeleteGameReleaseResponse, error) {
+
+	if err := s.embargo.DeleteGameRelease(ctx, req.GetGameId()); err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+	return &ratingextv1.DeleteGameReleaseResponse{}, nil
+}
+
+func (s *serverAPI) ListUpcomingReleases(ctx context.Context,
+	_ *ratingextv1.ListUpcomingReleasesRequest) (*ratingextv1.ListUpcomingReleasesResponse, error) {
+
+	releases, err := s.embargo.ListUpcomingReleases(ctx)
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	resp := &ratingextv1.ListUpcomingReleasesResponse{Games: make([]*ratingextv1.GameRelease, 0, len(releases))}
+	for _, rel := range releases {
+		resp.Games = append(resp.Games, toRelease(rel))
+	}
+	return resp, nil
+}
+
+func toRelease(rel entity.GameRelease) *ratingextv1.GameRelease {
+	return &ratingextv1.GameRelease{
+		GameId:    rel.GameID,
+		ReleaseAt: timestamppb.New(rel.ReleaseAt),
+		Policy:    string(rel.Policy),
+		UpdatedAt: timestamppb.New(rel.UpdatedAt),
+	}
+}
+
+func (s *serverAPI) GrantEmbargoExemption(ctx context.Context,
+	req *ratingextv1.GrantEmbargoExemptionRequest) (*ratingextv1.EmbargoExemption, error) {
+
+	e, err := s.embargo.GrantEmbargoExemption(ctx, entity.EmbargoExemption{
+		UserID:    req.GetUserId(),
+		GrantedBy: req.GetGrantedBy(),
+		Reason:    req.GetReason(),
+	})
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+	return toExemption(e), nil
+}
+
+func (s *serverAPI) RevokeEmbargoExemption(ctx context.Context,
+	req *ratingextv1.RevokeEmbargoExemptionRequest) (*ratingextv1.RevokeEmbargoExemptionResponse, error) {
+
+	if err := s.embargo.RevokeEmbargoExemption(ctx, req.GetUserId()); err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+	return &ratingextv1.RevokeEmbargoExemptionResponse{}, nil
+}
+
+func (s *serverAPI) ListEmbargoExemptions(ctx context.Context,
+	_ *ratingextv1.ListEmbargoExemptionsRequest) (*ratingextv1.ListEmbargoExemptionsResponse, error) {
+
+	users, err := s.embargo.ListEmbargoExemptions(ctx)
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	resp := &ratingextv1.ListEmbargoExemptionsResponse{Users: make([]*ratingextv1.EmbargoExemption, 0, len(users))}
+	for _, e := range users {
+		resp.Users = append(resp.Users, toExemption(e))
+	}
+	return resp, nil
+}
+
+func toExemption(e entity.EmbargoExemption) *ratingextv1.EmbargoExemption {
+	return &ratingextv1.EmbargoExemption{
+		UserId:    e.UserID,
+		GrantedBy: e.GrantedBy,
+		Reason:    e.Reason,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+}
+
+func fromTarget(t *ratingextv1.ModerationTarget) entity.ModerationTarget {
+	return entity.ModerationTarget{
+		UserID: t.GetUserId(),
+		GameID: t.GetGameId(),
+		From:   timestampOrZero(t.GetFrom()),
+		To:     timestampOrZero(t.GetTo()),
+	}
+}
+
+func toAction(a entity.ModerationAction) *ratingextv1.ModerationAction {
+	target := &ratingextv1.ModerationTarget{
+		UserId: a.Target.UserID,
+		GameId: a.Target.GameID,
+	}
+	if !a.Target.From.IsZero() {
+		target.From = timestamppb.New(a.Target.From)
+	}
+	if !a.Target.To.IsZero() {
+		target.To = timestamppb.New(a.Target.To)
+	}
+
+	return &ratingextv1.ModerationAction{
+		Id:        a.ID,
+		Action:    string(a.Type),
+		Moderator: a.Moderator,
+		Reason:    a.Reason,
+		Target:    target,
+		Affected:  a.Affected,
+		CreatedAt: timestamppb.New(a.CreatedAt),
+		Games:     a.Games,
+	}
+}
+
+func timestampOrZero(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
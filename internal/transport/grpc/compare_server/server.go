@@ -0,0 +1,67 @@
+package compare_server
+
+import (
+	"context"
+
+	ratingextv1 "github.com/RozmiDan/gameReviewHubRating/gen/go/ratingext"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+	"google.golang.org/grpc"
+)
+
+type CompareUseCase interface {
+	CompareGames(ctx context.Context, gameIDs []string, scale entity.Scale) (entity.Comparison, error)
+}
+
+type serverAPI struct {
+	ratingextv1.UnimplementedRatingCompareServiceServer
+	usecase CompareUseCase
+}
+
+func Register(grpcServer *grpc.Server, uc CompareUseCase) {
+	ratingextv1.RegisterRatingCompareServiceServer(grpcServer, &serverAPI{usecase: uc})
+}
+
+func (s *serverAPI) CompareGames(ctx context.Context,
+	req *ratingextv1.CompareGamesRequest) (*ratingextv1.CompareGamesResponse, error) {
+
+	cmp, err := s.usecase.CompareGames(ctx, req.GetGameIds(), entity.Scale(req.GetScale()))
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	resp := &ratingextv1.CompareGamesResponse{
+		Scale:      string(cmp.Scale),
+		Games:      make([]*ratingextv1.GameComparison, 0, len(cmp.Games)),
+		Pairs:      make([]*ratingextv1.PairComparison, 0, len(cmp.Pairs)),
+		Confidence: cmp.Params.Confidence,
+		Alpha:      cmp.Params.Alpha,
+	}
+	for _, g := range cmp.Games {
+		resp.Games = append(resp.Games, &ratingextv1.GameComparison{
+			GameId:        g.GameID,
+			AverageRating: g.AverageRating,
+			RatingsCount:  g.RatingsCount,
+			Distribution:  g.Distribution,
+			Rank:          g.Rank,
+			CiLow:         g.CILow,
+			CiHigh:        g.CIHigh,
+			Frozen:        g.Frozen,
+		})
+	}
+	for _, p := range cmp.Pairs {
+		resp.Pairs = append(resp.Pairs, &ratingextv1.PairComparison{
+			GameA:           p.GameA,
+			GameB:           p.GameB,
+			MeanDifference:  p.MeanDifference,
+			U:               p.U,
+			Z:               p.Z,
+			ProbSuperiority: p.ProbSuperiority,
+			PValue:          p.PValue,
+			PAdjusted:       p.PAdjusted,
+			Significant:     p.Significant,
+		})
+	}
+
+	return resp, nil
+}
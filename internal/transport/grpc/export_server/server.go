@@ -0,0 +1,119 @@
+package export_server
+
+import (
+	"context"
+	"io"
+
+	ratingextv1 "github.com/RozmiDan/gameReviewHubRating/gen/go/ratingext"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/bulk"
+	"google.golang.org/grpc"
+)
+
+type ExportUseCase interface {
+	Export(ctx context.Context, filter entity.ExportFilter, sink entity.ExportSink) error
+}
+
+// _chunkSize - размер data в одном ExportChunk; с запасом меньше лимита сообщения gRPC в 4 МБ.
+const _chunkSize = 256 << 10
+
+type serverAPI struct {
+	ratingextv1.UnimplementedRatingExportServiceServer
+	usecase ExportUseCase
+}
+
+func Register(grpcServer *grpc.Server, uc ExportUseCase) {
+	ratingextv1.RegisterRatingExportServiceServer(grpcServer, &serverAPI{usecase: uc})
+}
+
+func (s *serverAPI) ExportRatings(req *ratingextv1.ExportRatingsRequest,
+	stream grpc.ServerStreamingServer[ratingextv1.ExportChunk]) error {
+
+	format, ok := formats[req.GetFormat()]
+	if !ok {
+		verr := &entity.ValidationError{}
+		verr.Add("format", entity.ErrInvalidArgument, "unknown export format")
+		return apierr.GRPCStatus(verr)
+	}
+
+	filter := entity.ExportFilter{GameIDs: req.GetGameIds()}
+	if req.From != nil {
+		filter.From = req.GetFrom().AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.GetTo().AsTime()
+	}
+	for _, ds := range req.GetDatasets() {
+		filter.Datasets = append(filter.Datasets, datasetFromProto(ds))
+	}
+
+	sink := bulk.NewSink(format, func(ds entity.Dataset) (io.WriteCloser, error) {
+		return &chunkWriter{stream: stream, dataset: datasetToProto(ds)}, nil
+	})
+
+	return apierr.GRPCStatus(s.usecase.Export(stream.Context(), filter, sink))
+}
+
+var formats = map[ratingextv1.ExportFormat]bulk.Format{
+	ratingextv1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED: bulk.FormatCSV,
+	ratingextv1.ExportFormat_EXPORT_FORMAT_CSV:         bulk.FormatCSV,
+	ratingextv1.ExportFormat_EXPORT_FORMAT_JSONL:       bulk.FormatJSONL,
+	ratingextv1.ExportFormat_EXPORT_FORMAT_PARQUET:     bulk.FormatParquet,
+}
+
+func datasetFromProto(ds ratingextv1.Dataset) entity.Dataset {
+	switch ds {
+	case ratingextv1.Dataset_DATASET_RATINGS:
+		return entity.DatasetRatings
+	case ratingextv1.Dataset_DATASET_GAME_RATINGS:
+		return entity.DatasetGameRatings
+	default:
+		// валидатор вернёт понятную ошибку поля datasets
+		return entity.Dataset(ds.String())
+	}
+}
+
+func datasetToProto(ds entity.Dataset) ratingextv1.Dataset {
+	switch ds {
+	case entity.DatasetRatings:
+		return ratingextv1.Dataset_DATASET_RATINGS
+	case entity.DatasetGameRatings:
+		return ratingextv1.Dataset_DATASET_GAME_RATINGS
+	default:
+		return ratingextv1.Dataset_DATASET_UNSPECIFIED
+	}
+}
+
+// chunkWriter нарезает файл набора данных на ExportChunk; Close отправляет остаток с last=true.
+type chunkWriter struct {
+	stream  grpc.ServerStreamingServer[ratingextv1.ExportChunk]
+	dataset ratingextv1.Dataset
+	buf     []byte
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		take := min(len(p), _chunkSize-len(w.buf))
+		w.buf = append(w.buf, p[:take]...)
+		p = p[take:]
+
+		if len(w.buf) == _chunkSize {
+			if err := w.send(false); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (w *chunkWriter) Close() error {
+	return w.send(true)
+}
+
+func (w *chunkWriter) send(last bool) error {
+	err := w.stream.Send(&ratingextv1.ExportChunk{Dataset: w.dataset, Data: w.buf, Last: last})
+	w.buf = make([]byte, 0, _chunkSize)
+	return err
+}
@@ -0,0 +1,42 @@
+package grpc_rating
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/health"
+	ratingv1 "github.com/RozmiDan/gamehub-protos/gen/go/gamehub"
+	"go.uber.org/zap"
+	grpc_health "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const _healthPollInterval = 5 * time.Second
+
+// watchHealth синхронизирует grpc.health.v1 с результатами проверок готовности.
+func watchHealth(ctx context.Context, srv *grpc_health.Server, checker *health.Checker, logger *zap.Logger) {
+	ticker := time.NewTicker(_healthPollInterval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		ready, results := checker.Ready(ctx)
+
+		st := healthpb.HealthCheckResponse_SERVING
+		if !ready {
+			st = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		if st != last {
+			logger.Info("health status changed", zap.String("status", st.String()), zap.Any("checks", results))
+			last = st
+		}
+		srv.SetServingStatus("", st)
+		srv.SetServingStatus(ratingv1.RatingService_ServiceDesc.ServiceName, st)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
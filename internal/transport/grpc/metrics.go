@@ -0,0 +1,40 @@
+package grpc_rating
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func metricsInterceptor(m *metrics.Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		m.GRPCRequests.WithLabelValues(info.FullMethod, code.String()).Inc()
+		m.GRPCDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+
+		if submitMethods[info.FullMethod] {
+			m.ObserveSubmit(metrics.SourceGRPC, code)
+		}
+
+		return resp, err
+	}
+}
+
+// streamMetricsInterceptor - для стримов длительность считается до закрытия стрима.
+func streamMetricsInterceptor(m *metrics.Metrics) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+
+		m.GRPCRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		m.GRPCDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
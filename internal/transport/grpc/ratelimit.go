@@ -0,0 +1,79 @@
+package grpc_rating
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+
+	ratingextv1 "github.com/RozmiDan/gameReviewHubRating/gen/go/ratingext"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/ratelimit"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+	ratingv1 "github.com/RozmiDan/gamehub-protos/gen/go/gamehub"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	clientIDHeader   = "x-client-id"
+	retryAfterHeader = "retry-after"
+)
+
+type RateLimiter interface {
+	Allow(ctx context.Context, userID, clientID string) ratelimit.Result
+}
+
+// submitMethods - SubmitRating обеих версий RatingService (gamehub и ratingext).
+var submitMethods = map[string]bool{
+	ratingv1.RatingService_SubmitRating_FullMethodName:    true,
+	ratingextv1.RatingService_SubmitRating_FullMethodName: true,
+}
+
+// rateLimitInterceptor ограничивает только SubmitRating - чтение не трогаем.
+func rateLimitInterceptor(limiter RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !submitMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		var userID string
+		if r, ok := req.(interface{ GetUserId() string }); ok {
+			userID = r.GetUserId()
+		}
+
+		res := limiter.Allow(ctx, userID, clientID(ctx))
+		if res.Allowed {
+			return handler(ctx, req)
+		}
+
+		secs := int64(math.Ceil(res.RetryAfter.Seconds()))
+		_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, strconv.FormatInt(secs, 10)))
+
+		return nil, apierr.GRPCStatus(&entity.RetryableError{
+			Err:        entity.ErrRateLimited,
+			RetryAfter: res.RetryAfter,
+			Reason:     string(res.Scope) + " limit",
+		})
+	}
+}
+
+// clientID - явный x-client-id из метаданных, иначе адрес пира без порта.
+func clientID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(clientIDHeader); len(v) > 0 && v[0] != "" {
+			return v[0]
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
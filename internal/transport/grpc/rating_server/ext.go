@@ -0,0 +1,93 @@
+package rating_server
+
+import (
+	"context"
+
+	ratingextv1 "github.com/RozmiDan/gameReviewHubRating/gen/go/ratingext"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+)
+
+// extServerAPI - gamehub.ratingext.RatingService: те же операции, но шкала, порядок
+// и фильтр топа задаются в запросе, а в ответе - всё, что известно о рейтинге игры.
+type extServerAPI struct {
+	ratingextv1.UnimplementedRatingServiceServer
+	usecase RatingUseCase
+}
+
+func (s *extServerAPI) SubmitRating(ctx context.Context,
+	req *ratingextv1.SubmitRatingRequest) (*ratingextv1.SubmitRatingResponse, error) {
+
+	if err := s.usecase.SubmitRating(ctx, req.GetUserId(), req.GetGameId(), req.GetRating(),
+		entity.Scale(req.GetScale())); err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	return &ratingextv1.SubmitRatingResponse{}, nil
+}
+
+func (s *extServerAPI) GetGameRating(ctx context.Context,
+	req *ratingextv1.GetGameRatingRequest) (*ratingextv1.GameRating, error) {
+
+	game, err := s.usecase.GetGameRating(ctx, req.GetGameId(), entity.Scale(req.GetScale()))
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	return toGameRating(game), nil
+}
+
+func (s *extServerAPI) GetTopGames(ctx context.Context,
+	req *ratingextv1.GetTopGamesRequest) (*ratingextv1.GetTopGamesResponse, error) {
+
+	if err := validateTopLimit(req.GetLimit()); err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	order, err := entity.ParseTopOrder(req.GetOrder())
+	if err != nil {
+		verr := &entity.ValidationError{}
+		verr.Add("order", entity.ErrInvalidArgument, err.Error())
+		return nil, apierr.GRPCStatus(verr)
+	}
+
+	f := req.GetFilter()
+	filter := entity.TopFilter{
+		Genre:       f.GetGenre(),
+		Tags:        f.GetTags(),
+		ReleaseYear: f.GetReleaseYear(),
+		Publisher:   f.GetPublisher(),
+	}
+
+	scale := entity.Scale(req.GetScale())
+	list, err := s.usecase.GetTopGames(ctx, req.GetLimit(), req.GetOffset(), scale, order, filter)
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	if scale == "" {
+		scale = entity.ScaleTen
+	}
+	resp := &ratingextv1.GetTopGamesResponse{
+		Scale: string(scale),
+		Games: make([]*ratingextv1.GameRating, 0, len(list)),
+	}
+	for _, e := range list {
+		resp.Games = append(resp.Games, toGameRating(e))
+	}
+	return resp, nil
+}
+
+func toGameRating(g entity.GameRating) *ratingextv1.GameRating {
+	return &ratingextv1.GameRating{
+		GameId:           g.GameId,
+		AverageRating:    g.AverageRating,
+		RatingsCount:     g.RatingsCount,
+		Scale:            string(g.Scale),
+		NormalizedRating: g.NormalizedRating,
+		WeightedRating:   g.WeightedRating,
+		Frozen:           g.Frozen,
+		VerifiedRating:   g.VerifiedRating,
+		VerifiedCount:    g.VerifiedCount,
+	}
+}
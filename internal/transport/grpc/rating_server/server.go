@@ -2,44 +2,40 @@ package rating_server
 
 import (
 	"context"
-	"errors"
 
+	ratingextv1 "github.com/RozmiDan/gameReviewHubRating/gen/go/ratingext"
 	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
 	ratingv1 "github.com/RozmiDan/gamehub-protos/gen/go/gamehub"
-	"github.com/google/uuid"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type RatingUseCase interface {
-	SubmitRating(ctx context.Context, userID string, gameID string, rating int32) error
-	GetGameRating(ctx context.Context, gameID string) (entity.GameRating, error)
-	GetTopGames(ctx context.Context, limit, offset int32) ([]entity.GameRating, error)
+	SubmitRating(ctx context.Context, userID string, gameID string, rating int32, scale entity.Scale) error
+	GetGameRating(ctx context.Context, gameID string, scale entity.Scale) (entity.GameRating, error)
+	GetTopGames(ctx context.Context, limit, offset int32, scale entity.Scale, order entity.TopOrder,
+		filter entity.TopFilter) ([]entity.GameRating, error)
 }
 
+// serverAPI - gamehub.RatingService: в его proto нет полей для шкалы и порядка топа,
+// поэтому оценки и средние всегда на шкале 1-10, а топ - по обычному среднему. Остальное -
+// в gamehub.ratingext.RatingService (extServerAPI).
 type serverAPI struct {
 	ratingv1.UnimplementedRatingServiceServer
 	usecase RatingUseCase
 }
 
+// Register регистрирует gamehub.RatingService и его расширенную версию из ratingext.
 func Register(grpcServer *grpc.Server, uc RatingUseCase) {
 	ratingv1.RegisterRatingServiceServer(grpcServer, &serverAPI{usecase: uc})
+	ratingextv1.RegisterRatingServiceServer(grpcServer, &extServerAPI{usecase: uc})
 }
 
 func (s *serverAPI) SubmitRating(ctx context.Context,
 	req *ratingv1.SubmitRatingRequest) (*ratingv1.SubmitRatingResponse, error) {
 
-	if req.GetGameId() == "" || req.GetUserId() == "" || req.GetRating() == 0 {
-		return nil, status.Error(codes.InvalidArgument, "invalid entered data")
-	}
-
-	if !validateUUID(req.GetUserId(), req.GetGameId()) {
-		return nil, status.Error(codes.InvalidArgument, "invalid entered uuid")
-	}
-
-	if err := s.usecase.SubmitRating(ctx, req.UserId, req.GameId, req.Rating); err != nil {
-		return &ratingv1.SubmitRatingResponse{Success: false}, status.Error(codes.Internal, "could not submit rating")
+	if err := s.usecase.SubmitRating(ctx, req.UserId, req.GameId, req.Rating, entity.ScaleTen); err != nil {
+		return &ratingv1.SubmitRatingResponse{Success: false}, apierr.GRPCStatus(err)
 	}
 
 	return &ratingv1.SubmitRatingResponse{Success: true}, nil
@@ -49,21 +45,10 @@ func (s *serverAPI) SubmitRating(ctx context.Context,
 func (s *serverAPI) GetGameRating(ctx context.Context,
 	req *ratingv1.GetGameRatingRequest) (*ratingv1.GetGameRatingResponse, error) {
 
-	if req.GetGameId() == "" {
-		return &ratingv1.GetGameRatingResponse{}, status.Error(codes.InvalidArgument, "invalid gameID")
-	}
-
-	if !validateUUID(req.GetGameId()) {
-		return nil, status.Error(codes.InvalidArgument, "invalid entered uuid")
-	}
-
-	resEnt, err := s.usecase.GetGameRating(ctx, req.GameId)
+	resEnt, err := s.usecase.GetGameRating(ctx, req.GameId, entity.ScaleTen)
 
 	if err != nil {
-		if errors.Is(err, entity.ErrGameNotFound) {
-			return &ratingv1.GetGameRatingResponse{}, status.Error(codes.NotFound, "gameID not found")
-		}
-		return &ratingv1.GetGameRatingResponse{}, status.Error(codes.Internal, "internal error")
+		return &ratingv1.GetGameRatingResponse{}, apierr.GRPCStatus(err)
 	}
 
 	return &ratingv1.GetGameRatingResponse{
@@ -76,14 +61,14 @@ func (s *serverAPI) GetGameRating(ctx context.Context,
 func (s *serverAPI) GetTopGames(ctx context.Context,
 	req *ratingv1.GetTopGamesRequest) (*ratingv1.GetTopGamesResponse, error) {
 
-	if req.GetLimit() != 10 {
-		return &ratingv1.GetTopGamesResponse{}, status.Error(codes.InvalidArgument, "invalid limit")
+	if err := validateTopLimit(req.GetLimit()); err != nil {
+		return &ratingv1.GetTopGamesResponse{}, apierr.GRPCStatus(err)
 	}
 
-	list, err := s.usecase.GetTopGames(ctx, req.Limit, req.Offset)
+	list, err := s.usecase.GetTopGames(ctx, req.Limit, req.Offset, entity.ScaleTen, entity.TopOrderAverage, entity.TopFilter{})
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, "could not get top games")
+		return nil, apierr.GRPCStatus(err)
 	}
 
 	resp := &ratingv1.GetTopGamesResponse{}
@@ -97,11 +82,11 @@ func (s *serverAPI) GetTopGames(ctx context.Context,
 	return resp, nil
 }
 
-func validateUUID(uuids ...string) bool {
-	for _, it := range uuids {
-		if err := uuid.Validate(it); err != nil {
-			return false
-		}
+func validateTopLimit(limit int32) error {
+	if limit != 10 {
+		verr := &entity.ValidationError{}
+		verr.Add("limit", entity.ErrInvalidArgument, "limit must be 10")
+		return verr
 	}
-	return true
+	return nil
 }
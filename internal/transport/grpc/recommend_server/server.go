@@ -0,0 +1,52 @@
+package recommend_server
+
+import (
+	"context"
+
+	ratingextv1 "github.com/RozmiDan/gameReviewHubRating/gen/go/ratingext"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+	"google.golang.org/grpc"
+)
+
+type RecommendUseCase interface {
+	RecommendGames(ctx context.Context, userID string, limit int, excludeRated bool) ([]entity.Recommendation, error)
+}
+
+type serverAPI struct {
+	ratingextv1.UnimplementedRatingRecommendServiceServer
+	usecase RecommendUseCase
+}
+
+func Register(grpcServer *grpc.Server, uc RecommendUseCase) {
+	ratingextv1.RegisterRatingRecommendServiceServer(grpcServer, &serverAPI{usecase: uc})
+}
+
+var sources = map[entity.RecommendationSource]ratingextv1.RecommendationSource{
+	entity.SourceItemCF:     ratingextv1.RecommendationSource_RECOMMENDATION_SOURCE_ITEM_CF,
+	entity.SourcePopularity: ratingextv1.RecommendationSource_RECOMMENDATION_SOURCE_POPULARITY,
+}
+
+func (s *serverAPI) RecommendGames(ctx context.Context,
+	req *ratingextv1.RecommendGamesRequest) (*ratingextv1.RecommendGamesResponse, error) {
+
+	recs, err := s.usecase.RecommendGames(ctx, req.GetUserId(), int(req.GetLimit()), req.GetExcludeRated())
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	resp := &ratingextv1.RecommendGamesResponse{
+		UserId: req.GetUserId(),
+		Games:  make([]*ratingextv1.Recommendation, 0, len(recs)),
+	}
+	for _, r := range recs {
+		resp.Games = append(resp.Games, &ratingextv1.Recommendation{
+			GameId:         r.GameID,
+			PredictedScore: r.PredictedScore,
+			Source:         sources[r.Source],
+			Support:        r.Support,
+		})
+	}
+
+	return resp, nil
+}
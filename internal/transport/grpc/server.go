@@ -2,56 +2,122 @@ package grpc_rating
 
 import (
 	"context"
+	"errors"
 	"net"
-	"os"
-	"os/signal"
-	"syscall"
 
 	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/health"
+	"github.com/RozmiDan/gameReviewHubRating/internal/metrics"
 	"github.com/RozmiDan/gameReviewHubRating/internal/transport/grpc/rating_server"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	grpc_health "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type RatingUseCase interface {
-	SubmitRating(ctx context.Context, userID string, gameID string, rating int32) error
-	GetGameRating(ctx context.Context, gameID string) (entity.GameRating, error)
-	GetTopGames(ctx context.Context, limit, offset int32) ([]entity.GameRating, error)
+	SubmitRating(ctx context.Context, userID string, gameID string, rating int32, scale entity.Scale) error
+	GetGameRating(ctx context.Context, gameID string, scale entity.Scale) (entity.GameRating, error)
+	GetTopGames(ctx context.Context, limit, offset int32, scale entity.Scale, order entity.TopOrder,
+		filter entity.TopFilter) ([]entity.GameRating, error)
 }
 
-func StartServer(ctx context.Context, addr string, logger *zap.Logger, uc RatingUseCase) error {
+// Registrar регистрирует на сервере дополнительный gRPC-сервис (экспорт, админка и т.п.).
+type Registrar func(*grpc.Server)
+
+type Server struct {
+	addr      string
+	grpcSrv   *grpc.Server
+	healthSrv *grpc_health.Server
+	checker   *health.Checker
+	logger    *zap.Logger
+
+	cancel context.CancelFunc
+	failed chan error
+}
+
+func NewServer(addr string, logger *zap.Logger, uc RatingUseCase, limiter RateLimiter, m *metrics.Metrics,
+	checker *health.Checker, services ...Registrar) *Server {
 	grpcSrv := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc_middleware.WithUnaryServerChain(
 			grpc_recovery.UnaryServerInterceptor(),
 			grpc_zap.UnaryServerInterceptor(logger),
+			metricsInterceptor(m),
+			rateLimitInterceptor(limiter),
+		),
+		grpc_middleware.WithStreamServerChain(
+			grpc_recovery.StreamServerInterceptor(),
+			grpc_zap.StreamServerInterceptor(logger),
+			streamMetricsInterceptor(m),
 		),
 	)
 
 	rating_server.Register(grpcSrv, uc)
+	for _, register := range services {
+		register(grpcSrv)
+	}
+
+	healthSrv := grpc_health.NewServer()
+	healthpb.RegisterHealthServer(grpcSrv, healthSrv)
+
+	return &Server{
+		addr:      addr,
+		grpcSrv:   grpcSrv,
+		healthSrv: healthSrv,
+		checker:   checker,
+		logger:    logger,
+		failed:    make(chan error, 1),
+	}
+}
 
-	lis, err := net.Listen("tcp", addr)
+func (s *Server) Name() string { return "grpc" }
+
+func (s *Server) Start(ctx context.Context) error {
+	lis, err := net.Listen("tcp", s.addr)
 	if err != nil {
 		return err
 	}
 
-	errCh := make(chan error, 1)
+	ctx, s.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	go watchHealth(ctx, s.healthSrv, s.checker, s.logger)
+
 	go func() {
-		logger.Info("gRPC serving", zap.String("addr", addr))
-		errCh <- grpcSrv.Serve(lis)
+		s.logger.Info("gRPC serving", zap.String("addr", s.addr))
+		if err := s.grpcSrv.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			s.failed <- err
+		}
+		close(s.failed)
 	}()
 
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	return nil
+}
+
+// Stop переводит health в NOT_SERVING и дожидается текущих RPC;
+// если дедлайн ctx истёк раньше - обрывает их.
+func (s *Server) Stop(ctx context.Context) error {
+	s.cancel()
+	s.healthSrv.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		s.grpcSrv.GracefulStop()
+		close(done)
+	}()
 
 	select {
+	case <-done:
+		return nil
 	case <-ctx.Done():
-		logger.Info("shutdown signal received, stopping gRPC")
-		grpcSrv.GracefulStop()
-		return <-errCh
-	case err := <-errCh:
-		return err
+		s.grpcSrv.Stop()
+		return ctx.Err()
 	}
 }
+
+func (s *Server) Failed() <-chan error {
+	return s.failed
+}
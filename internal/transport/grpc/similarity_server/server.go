@@ -0,0 +1,48 @@
+package similarity_server
+
+import (
+	"context"
+
+	ratingextv1 "github.com/RozmiDan/gameReviewHubRating/gen/go/ratingext"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SimilarityUseCase interface {
+	GetSimilarGames(ctx context.Context, gameID string, k int) ([]entity.SimilarGame, error)
+}
+
+type serverAPI struct {
+	ratingextv1.UnimplementedRatingSimilarityServiceServer
+	usecase SimilarityUseCase
+}
+
+func Register(grpcServer *grpc.Server, uc SimilarityUseCase) {
+	ratingextv1.RegisterRatingSimilarityServiceServer(grpcServer, &serverAPI{usecase: uc})
+}
+
+func (s *serverAPI) GetSimilarGames(ctx context.Context,
+	req *ratingextv1.GetSimilarGamesRequest) (*ratingextv1.GetSimilarGamesResponse, error) {
+
+	games, err := s.usecase.GetSimilarGames(ctx, req.GetGameId(), int(req.GetK()))
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	resp := &ratingextv1.GetSimilarGamesResponse{
+		GameId: req.GetGameId(),
+		Games:  make([]*ratingextv1.SimilarGame, 0, len(games)),
+	}
+	for _, g := range games {
+		resp.Games = append(resp.Games, &ratingextv1.SimilarGame{
+			GameId:     g.GameID,
+			Similarity: g.Similarity,
+			CoRaters:   g.CoRaters,
+			ComputedAt: timestamppb.New(g.ComputedAt),
+		})
+	}
+
+	return resp, nil
+}
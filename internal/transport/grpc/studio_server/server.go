@@ -0,0 +1,109 @@
+package studio_server
+
+import (
+	"context"
+
+	ratingextv1 "github.com/RozmiDan/gameReviewHubRating/gen/go/ratingext"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+	"google.golang.org/grpc"
+)
+
+type StudioUseCase interface {
+	GetTopStudios(ctx context.Context, role entity.StudioRole, minRatings int64, limit, offset int32,
+		scale entity.Scale) ([]entity.StudioRating, error)
+	ListStudioGames(ctx context.Context, role entity.StudioRole, name string, limit, offset int32,
+		scale entity.Scale) (entity.StudioRating, []entity.GameRating, error)
+}
+
+type serverAPI struct {
+	ratingextv1.UnimplementedRatingStudioServiceServer
+	usecase StudioUseCase
+}
+
+func Register(grpcServer *grpc.Server, uc StudioUseCase) {
+	ratingextv1.RegisterRatingStudioServiceServer(grpcServer, &serverAPI{usecase: uc})
+}
+
+func (s *serverAPI) GetTopStudios(ctx context.Context,
+	req *ratingextv1.GetTopStudiosRequest) (*ratingextv1.GetTopStudiosResponse, error) {
+
+	studios, err := s.usecase.GetTopStudios(ctx, roleFromProto(req.GetRole()), req.GetMinRatings(),
+		req.GetLimit(), req.GetOffset(), entity.Scale(req.GetScale()))
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	scale := req.GetScale()
+	if scale == "" {
+		scale = string(entity.ScaleTen)
+	}
+
+	resp := &ratingextv1.GetTopStudiosResponse{
+		Scale:   scale,
+		Studios: make([]*ratingextv1.StudioRating, 0, len(studios)),
+	}
+	for _, st := range studios {
+		resp.Studios = append(resp.Studios, studioToProto(st))
+	}
+
+	return resp, nil
+}
+
+func (s *serverAPI) ListStudioGames(ctx context.Context,
+	req *ratingextv1.ListStudioGamesRequest) (*ratingextv1.ListStudioGamesResponse, error) {
+
+	studio, games, err := s.usecase.ListStudioGames(ctx, roleFromProto(req.GetRole()), req.GetName(),
+		req.GetLimit(), req.GetOffset(), entity.Scale(req.GetScale()))
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	resp := &ratingextv1.ListStudioGamesResponse{
+		Studio: studioToProto(studio),
+		Scale:  string(studio.Scale),
+		Games:  make([]*ratingextv1.StudioGame, 0, len(games)),
+	}
+	for _, g := range games {
+		resp.Games = append(resp.Games, &ratingextv1.StudioGame{
+			GameId:        g.GameId,
+			AverageRating: g.AverageRating,
+			RatingsCount:  g.RatingsCount,
+			Frozen:        g.Frozen,
+		})
+	}
+
+	return resp, nil
+}
+
+func studioToProto(st entity.StudioRating) *ratingextv1.StudioRating {
+	return &ratingextv1.StudioRating{
+		Role:          roleToProto(st.Role),
+		Name:          st.Name,
+		AverageRating: st.AverageRating,
+		RatingsCount:  st.RatingsCount,
+		GamesCount:    st.GamesCount,
+	}
+}
+
+func roleFromProto(r ratingextv1.StudioRole) entity.StudioRole {
+	switch r {
+	case ratingextv1.StudioRole_STUDIO_ROLE_DEVELOPER:
+		return entity.StudioDeveloper
+	case ratingextv1.StudioRole_STUDIO_ROLE_PUBLISHER:
+		return entity.StudioPublisher
+	default:
+		return ""
+	}
+}
+
+func roleToProto(r entity.StudioRole) ratingextv1.StudioRole {
+	switch r {
+	case entity.StudioDeveloper:
+		return ratingextv1.StudioRole_STUDIO_ROLE_DEVELOPER
+	case entity.StudioPublisher:
+		return ratingextv1.StudioRole_STUDIO_ROLE_PUBLISHER
+	default:
+		return ratingextv1.StudioRole_STUDIO_ROLE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,67 @@
+package timeline_server
+
+import (
+	"context"
+	"time"
+
+	ratingextv1 "github.com/RozmiDan/gameReviewHubRating/gen/go/ratingext"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TimelineUseCase interface {
+	GetRatingTimeline(ctx context.Context, gameID string, from, to time.Time,
+		g entity.Granularity, scale entity.Scale) (entity.Timeline, error)
+}
+
+type serverAPI struct {
+	ratingextv1.UnimplementedRatingTimelineServiceServer
+	usecase TimelineUseCase
+}
+
+func Register(grpcServer *grpc.Server, uc TimelineUseCase) {
+	ratingextv1.RegisterRatingTimelineServiceServer(grpcServer, &serverAPI{usecase: uc})
+}
+
+func (s *serverAPI) GetRatingTimeline(ctx context.Context,
+	req *ratingextv1.GetRatingTimelineRequest) (*ratingextv1.GetRatingTimelineResponse, error) {
+
+	g, err := entity.ParseGranularity(req.GetGranularity())
+	if err != nil {
+		verr := &entity.ValidationError{}
+		verr.Add("granularity", entity.ErrInvalidArgument, err.Error())
+		return nil, apierr.GRPCStatus(verr)
+	}
+
+	timeline, err := s.usecase.GetRatingTimeline(ctx, req.GetGameId(),
+		timestampOrZero(req.GetFrom()), timestampOrZero(req.GetTo()), g, entity.Scale(req.GetScale()))
+	if err != nil {
+		return nil, apierr.GRPCStatus(err)
+	}
+
+	resp := &ratingextv1.GetRatingTimelineResponse{
+		GameId:      timeline.GameID,
+		Granularity: string(timeline.Granularity),
+		Scale:       string(timeline.Scale),
+		Points:      make([]*ratingextv1.TimelinePoint, 0, len(timeline.Points)),
+	}
+	for _, p := range timeline.Points {
+		resp.Points = append(resp.Points, &ratingextv1.TimelinePoint{
+			At:            timestamppb.New(p.At),
+			AverageRating: p.AverageRating,
+			RatingsCount:  p.RatingsCount,
+			Distribution:  p.Distribution,
+		})
+	}
+
+	return resp, nil
+}
+
+func timestampOrZero(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
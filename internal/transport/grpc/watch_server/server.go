@@ -0,0 +1,63 @@
+package watch_server
+
+import (
+	"context"
+
+	ratingextv1 "github.com/RozmiDan/gameReviewHubRating/gen/go/ratingext"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WatchUseCase interface {
+	Watch(ctx context.Context, gameIDs []string, scale entity.Scale) (entity.RatingStream, error)
+}
+
+type serverAPI struct {
+	ratingextv1.UnimplementedRatingWatchServiceServer
+	usecase WatchUseCase
+}
+
+func Register(grpcServer *grpc.Server, uc WatchUseCase) {
+	ratingextv1.RegisterRatingWatchServiceServer(grpcServer, &serverAPI{usecase: uc})
+}
+
+// WatchGameRatings - Send блокируется по flow control gRPC, пока клиент не прочитает;
+// всё это время Hub схлопывает новые обновления у себя.
+func (s *serverAPI) WatchGameRatings(req *ratingextv1.WatchGameRatingsRequest,
+	stream grpc.ServerStreamingServer[ratingextv1.GameRatingUpdate]) error {
+
+	ctx := stream.Context()
+
+	updates, err := s.usecase.Watch(ctx, req.GetGameIds(), entity.Scale(req.GetScale()))
+	if err != nil {
+		return apierr.GRPCStatus(err)
+	}
+	defer updates.Close()
+
+	for {
+		batch, err := updates.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return apierr.GRPCStatus(err)
+		}
+
+		for _, u := range batch {
+			msg := &ratingextv1.GameRatingUpdate{
+				GameId:        u.GameId,
+				AverageRating: u.AverageRating,
+				RatingsCount:  u.RatingsCount,
+				Scale:         string(u.Scale),
+			}
+			if !u.UpdatedAt.IsZero() {
+				msg.UpdatedAt = timestamppb.New(u.UpdatedAt)
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
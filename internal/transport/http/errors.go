@@ -0,0 +1,45 @@
+package http_serv
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+)
+
+type errorBody struct {
+	Error errorPayload `json:"error"`
+}
+
+type errorPayload struct {
+	Code              string             `json:"code"`
+	Reason            string             `json:"reason"`
+	Message           string             `json:"message"`
+	Violations        []apierr.Violation `json:"violations,omitempty"`
+	RetryAfterSeconds int64              `json:"retry_after_seconds,omitempty"`
+	Metadata          map[string]string  `json:"metadata,omitempty"`
+}
+
+// WriteError отвечает ошибкой в том же виде, что и gRPC-транспорт (см. apierr).
+func WriteError(w http.ResponseWriter, err error) {
+	p := apierr.Classify(err)
+
+	body := errorBody{Error: errorPayload{
+		Code:       p.Code.String(),
+		Reason:     p.Reason,
+		Message:    p.Message,
+		Violations: p.Violations,
+		Metadata:   p.Metadata,
+	}}
+	if p.RetryAfter > 0 {
+		secs := int64(math.Ceil(p.RetryAfter.Seconds()))
+		body.Error.RetryAfterSeconds = secs
+		w.Header().Set("Retry-After", strconv.FormatInt(secs, 10))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(p.HTTPStatus)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,38 @@
+package http_serv
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/health"
+)
+
+type readyResponse struct {
+	Status string          `json:"status"`
+	Checks []health.Result `json:"checks"`
+}
+
+// healthz - liveness: процесс жив и обслуживает HTTP.
+func healthz(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
+
+// readyz - readiness: все зависимости доступны и сервис не останавливается.
+func readyz(checker *health.Checker) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ready, results := checker.Ready(r.Context())
+
+		resp := readyResponse{Status: "ready", Checks: results}
+		code := http.StatusOK
+		if !ready {
+			resp.Status = "not_ready"
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
@@ -0,0 +1,60 @@
+package http_serv
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"go.uber.org/zap"
+)
+
+// Server - HTTP-сервер для /metrics и проверок здоровья.
+type Server struct {
+	srv    *http.Server
+	logger *zap.Logger
+	failed chan error
+}
+
+func NewServer(cfg config.HTTPConfig, handler http.Handler, logger *zap.Logger) *Server {
+	return &Server{
+		srv: &http.Server{
+			Addr:              cfg.Address,
+			Handler:           handler,
+			ReadTimeout:       cfg.ReadTimeout,
+			ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+			WriteTimeout:      cfg.WriteTimeout,
+			IdleTimeout:       cfg.IdleTimeout,
+		},
+		logger: logger,
+		failed: make(chan error, 1),
+	}
+}
+
+func (s *Server) Name() string { return "http" }
+
+func (s *Server) Start(_ context.Context) error {
+	lis, err := net.Listen("tcp", s.srv.Addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		s.logger.Info("starting metrics HTTP server", zap.String("addr", s.srv.Addr))
+		if err := s.srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.failed <- err
+		}
+		close(s.failed)
+	}()
+
+	return nil
+}
+
+func (s *Server) Stop(ctx context.Context) error {
+	return s.srv.Shutdown(ctx)
+}
+
+func (s *Server) Failed() <-chan error {
+	return s.failed
+}
@@ -2,14 +2,23 @@ package http_serv
 
 import (
 	"net/http"
+	"time"
 
+	"github.com/RozmiDan/gameReviewHubRating/internal/health"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 )
 
-func New(logger *zap.Logger) *http.ServeMux {
+// New собирает HTTP-роуты; watch == nil - живые обновления выключены.
+func New(logger *zap.Logger, reg *prometheus.Registry, checker *health.Checker, watch WatchUseCase, heartbeat time.Duration) *http.ServeMux {
 	mux := http.NewServeMux()
-	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg}))
+	mux.HandleFunc("/healthz", healthz)
+	mux.Handle("/readyz", readyz(checker))
+	if watch != nil {
+		mux.Handle("GET /v1/games/ratings/watch", watchRatings(watch, heartbeat, logger))
+	}
 
 	return mux
 }
@@ -0,0 +1,128 @@
+package http_serv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+	"go.uber.org/zap"
+)
+
+type WatchUseCase interface {
+	Watch(ctx context.Context, gameIDs []string, scale entity.Scale) (entity.RatingStream, error)
+}
+
+type ratingEvent struct {
+	GameID        string     `json:"game_id"`
+	AverageRating float64    `json:"average_rating"`
+	RatingsCount  int64      `json:"ratings_count"`
+	Scale         string     `json:"scale"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+// watchRatings - Server-Sent Events с обновлениями рейтингов:
+// GET /v1/games/ratings/watch?game_id=<uuid>&game_id=<uuid>&scale=ten.
+// Каждое обновление - событие "rating", раз в heartbeat - комментарий, чтобы
+// прокси не закрывали простаивающее соединение. Ошибка посреди потока приходит
+// событием "error" в том же JSON, что и обычные HTTP-ошибки.
+func watchRatings(uc WatchUseCase, heartbeat time.Duration, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var gameIDs []string
+		for _, v := range r.URL.Query()["game_id"] {
+			for _, id := range strings.Split(v, ",") {
+				if id = strings.TrimSpace(id); id != "" {
+					gameIDs = append(gameIDs, id)
+				}
+			}
+		}
+
+		updates, err := uc.Watch(ctx, gameIDs, entity.Scale(r.URL.Query().Get("scale")))
+		if err != nil {
+			WriteError(w, err)
+			return
+		}
+		defer updates.Close()
+
+		// WriteTimeout сервера рассчитан на обычные запросы, стрим живёт дольше
+		rc := http.NewResponseController(w)
+		_ = rc.SetWriteDeadline(time.Time{})
+
+		h := w.Header()
+		h.Set("Content-Type", "text/event-stream")
+		h.Set("Cache-Control", "no-cache")
+		h.Set("Connection", "keep-alive")
+		h.Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		if err := rc.Flush(); err != nil {
+			return
+		}
+
+		for {
+			waitCtx, cancel := context.WithTimeout(ctx, heartbeat)
+			batch, err := updates.Next(waitCtx)
+			cancel()
+
+			switch {
+			case ctx.Err() != nil:
+				return
+			case errors.Is(err, context.DeadlineExceeded):
+				_, err = fmt.Fprint(w, ": ping\n\n")
+			case err != nil:
+				writeErrorEvent(w, err)
+				_ = rc.Flush()
+				logger.Info("watch stream ended", zap.Error(err))
+				return
+			default:
+				err = writeRatingEvents(w, batch)
+			}
+
+			if err == nil {
+				err = rc.Flush()
+			}
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+func writeRatingEvents(w http.ResponseWriter, batch []entity.GameRatingUpdate) error {
+	for _, u := range batch {
+		ev := ratingEvent{
+			GameID:        u.GameId,
+			AverageRating: u.AverageRating,
+			RatingsCount:  u.RatingsCount,
+			Scale:         string(u.Scale),
+		}
+		if !u.UpdatedAt.IsZero() {
+			ev.UpdatedAt = &u.UpdatedAt
+		}
+		data, err := json.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "event: rating\ndata: %s\n\n", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeErrorEvent(w http.ResponseWriter, err error) {
+	p := apierr.Classify(err)
+	data, _ := json.Marshal(errorBody{Error: errorPayload{
+		Code:     p.Code.String(),
+		Reason:   p.Reason,
+		Message:  p.Message,
+		Metadata: p.Metadata,
+	}})
+	_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+}
@@ -4,39 +4,109 @@ package kafka_rating
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/RozmiDan/gameReviewHubRating/internal/config"
 	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/metrics"
+	"github.com/RozmiDan/gameReviewHubRating/internal/ratelimit"
+	"github.com/RozmiDan/gameReviewHubRating/internal/transport/apierr"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 )
 
 type RatingUseCase interface {
-	SubmitRating(ctx context.Context, userID string, gameID string, rating int32) error
+	SubmitRating(ctx context.Context, userID string, gameID string, rating int32, scale entity.Scale) error
 }
 
+type RateLimiter interface {
+	Allow(ctx context.Context, userID, clientID string) ratelimit.Result
+}
+
+// clientID, под которым consumer проходит через per-client лимит
+const clientID = "kafka"
+
 type Consumer struct {
 	reader  *kafka.Reader
+	dlq     *DLQ
 	handler RatingUseCase
+	limiter RateLimiter
+	metrics *metrics.Metrics
 	logger  *zap.Logger
+
+	mu       sync.Mutex
+	fetchErr error
+
+	cancel context.CancelFunc
+	done   chan struct{}
 }
 
-func NewConsumer(cfg config.KafkaConfig, handler RatingUseCase, logger *zap.Logger) *Consumer {
+func NewConsumer(cfg config.KafkaConfig, handler RatingUseCase, limiter RateLimiter, m *metrics.Metrics, logger *zap.Logger) *Consumer {
+	startOffset := kafka.FirstOffset
+	if cfg.StartOffset == "last" {
+		startOffset = kafka.LastOffset
+	}
+
 	r := kafka.NewReader(kafka.ReaderConfig{
-		Brokers:  cfg.Brokers,
-		GroupID:  cfg.GroupID,
-		Topic:    cfg.TopicRatings,
-		MinBytes: cfg.MinBytes,
-		MaxBytes: cfg.MaxBytes,
+		Brokers:          cfg.Brokers,
+		GroupID:          cfg.GroupID,
+		Topic:            cfg.TopicRatings,
+		MinBytes:         cfg.MinBytes,
+		MaxBytes:         cfg.MaxBytes,
+		MaxWait:          cfg.MaxWait,
+		ReadBatchTimeout: cfg.ReadTimeout,
+		CommitInterval:   cfg.CommitInterval,
+		StartOffset:      startOffset,
+		Dialer: &kafka.Dialer{
+			Timeout:   cfg.DialTimeout,
+			DualStack: true,
+		},
 	})
 	return &Consumer{
 		reader:  r,
+		dlq:     NewDLQ(cfg),
 		handler: handler,
+		limiter: limiter,
+		metrics: m,
 		logger:  logger.With(zap.String("component", "kafka-consumer")),
 	}
 }
 
-func (c *Consumer) Start(ctx context.Context) {
+func (c *Consumer) Name() string { return "kafka-consumer" }
+
+// Start запускает цикл чтения в отдельной горутине.
+func (c *Consumer) Start(ctx context.Context) error {
+	ctx, c.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	c.done = make(chan struct{})
+
+	go func() {
+		defer close(c.done)
+		c.run(ctx)
+	}()
+
+	return nil
+}
+
+// Stop прекращает чтение, дожидается обработки текущего сообщения и закрывает reader.
+func (c *Consumer) Stop(ctx context.Context) error {
+	c.cancel()
+
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		c.logger.Warn("consumer did not drain before deadline")
+	}
+
+	return c.Close()
+}
+
+func (c *Consumer) run(ctx context.Context) {
 	c.logger.Info("starting kafka consumer loop")
 	for {
 		m, err := c.reader.FetchMessage(ctx)
@@ -46,27 +116,134 @@ func (c *Consumer) Start(ctx context.Context) {
 				return
 			}
 			c.logger.Error("fetch message failed", zap.Error(err))
+			c.metrics.ConsumerErrors.WithLabelValues("fetch").Inc()
+			c.setFetchErr(err)
 			continue
 		}
+		c.setFetchErr(nil)
 
-		var msg entity.RatingMessage
-		if err := json.Unmarshal(m.Value, &msg); err != nil {
-			c.logger.Error("invalid message, skipping", zap.Error(err))
-			c.reader.CommitMessages(ctx, m)
-			continue
+		c.handle(ctx, m)
+	}
+}
+
+// handle обрабатывает сообщение до конца даже при остановке consumer'а -
+// отмена loopCtx прерывает только ожидание rate limit'а. Смещение фиксируется после
+// записи оценки или публикации в DLQ: если consumer остановили до этого или DLQ
+// недоступна, сообщение прочитается снова.
+func (c *Consumer) handle(loopCtx context.Context, m kafka.Message) {
+	ctx, span := startMessageSpan(context.WithoutCancel(loopCtx), m)
+	defer span.End()
+
+	start := time.Now()
+	defer func() { c.metrics.ConsumerProcessing.Observe(time.Since(start).Seconds()) }()
+	c.metrics.SetLag(m.Topic, m.Partition, m.HighWaterMark-m.Offset-1)
+
+	logger := tracing.Logger(ctx, c.logger)
+
+	if c.process(loopCtx, ctx, logger, m) {
+		c.commit(ctx, logger, m)
+	}
+}
+
+// process возвращает true, если сообщение обработано или ушло в DLQ и его можно фиксировать.
+func (c *Consumer) process(loopCtx, ctx context.Context, logger *zap.Logger, m kafka.Message) bool {
+	var msg entity.RatingMessage
+	if err := json.Unmarshal(m.Value, &msg); err != nil {
+		logger.Error("invalid message, skipping", zap.Error(err))
+		c.metrics.ConsumerErrors.WithLabelValues("decode").Inc()
+		return c.toDLQ(ctx, logger, m, DLQReasonDecode, err)
+	}
+
+	if err := c.waitLimit(loopCtx, msg); err != nil {
+		if errors.Is(err, entity.ErrRateLimited) {
+			logger.Warn("user rate limit exceeded, sending to dlq",
+				zap.String("game_id", msg.GameID), zap.String("user_id", msg.UserID))
+			return c.toDLQ(ctx, logger, m, DLQReasonRateLimited, err)
+		}
+		logger.Info("consumer stopped while waiting for rate limit, message left uncommitted")
+		return false
+	}
+
+	err := c.handler.SubmitRating(ctx, msg.UserID, msg.GameID, msg.Rating, entity.Scale(msg.Scale))
+	c.metrics.ObserveSubmit(metrics.SourceKafka, apierr.Classify(err).Code)
+	if err == nil {
+		return true
+	}
+
+	trace.SpanFromContext(ctx).RecordError(err)
+	c.metrics.ConsumerErrors.WithLabelValues("handler").Inc()
+	var verr *entity.ValidationError
+	if errors.As(err, &verr) {
+		logger.Warn("message rejected by validation", zap.Error(err),
+			zap.String("game_id", msg.GameID), zap.String("user_id", msg.UserID))
+		return c.toDLQ(ctx, logger, m, DLQReasonValidation, err)
+	}
+	if errors.Is(err, entity.ErrEmbargoed) {
+		logger.Warn("message rejected by release embargo", zap.Error(err),
+			zap.String("game_id", msg.GameID), zap.String("user_id", msg.UserID))
+		return c.toDLQ(ctx, logger, m, DLQReasonEmbargo, err)
+	}
+	logger.Error("handler error", zap.Error(err),
+		zap.String("game_id", msg.GameID), zap.String("user_id", msg.UserID))
+	return c.toDLQ(ctx, logger, m, DLQReasonHandler, err)
+}
+
+func (c *Consumer) commit(ctx context.Context, logger *zap.Logger, m kafka.Message) {
+	if err := c.reader.CommitMessages(ctx, m); err != nil {
+		logger.Warn("commit failed", zap.Error(err))
+		c.metrics.ConsumerErrors.WithLabelValues("commit").Inc()
+	}
+}
+
+// toDLQ возвращает false, если публикация не удалась - тогда смещение не фиксируется.
+func (c *Consumer) toDLQ(ctx context.Context, logger *zap.Logger, m kafka.Message, reason string, cause error) bool {
+	if err := c.dlq.Publish(ctx, m, reason, cause); err != nil {
+		logger.Error("publish to dlq failed, message left uncommitted", zap.String("reason", reason), zap.Error(err))
+		c.metrics.ConsumerErrors.WithLabelValues("dlq").Inc()
+		return false
+	}
+	return true
+}
+
+// waitLimit притормаживает consumer при глобальном/клиентском лимите. При лимите
+// пользователя возвращает entity.ErrRateLimited - такое сообщение уходит в DLQ,
+// при остановке consumer'а - ошибку ctx.
+func (c *Consumer) waitLimit(ctx context.Context, msg entity.RatingMessage) error {
+	for {
+		res := c.limiter.Allow(ctx, msg.UserID, clientID)
+		if res.Allowed {
+			return nil
 		}
 
-		if err := c.reader.CommitMessages(ctx, m); err != nil {
-			c.logger.Warn("commit failed", zap.Error(err))
+		if res.Scope == ratelimit.ScopeUser {
+			c.metrics.ObserveSubmit(metrics.SourceKafka, codes.ResourceExhausted)
+			return entity.ErrRateLimited
 		}
 
-		if err := c.handler.SubmitRating(ctx, msg.UserID, msg.GameID, msg.Rating); err != nil {
-			c.logger.Error("handler error", zap.Error(err),
-				zap.String("game_id", msg.GameID), zap.String("user_id", msg.UserID))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(res.RetryAfter):
 		}
 	}
 }
 
+func (c *Consumer) setFetchErr(err error) {
+	c.mu.Lock()
+	c.fetchErr = err
+	c.mu.Unlock()
+}
+
+// Health - ошибка последнего FetchMessage, если после неё не было успешного чтения.
+func (c *Consumer) Health(_ context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fetchErr != nil {
+		return fmt.Errorf("kafka fetch failed: %w", c.fetchErr)
+	}
+	return nil
+}
+
 func (c *Consumer) Close() error {
-	return c.reader.Close()
+	return errors.Join(c.reader.Close(), c.dlq.Close())
 }
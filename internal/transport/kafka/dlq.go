@@ -0,0 +1,145 @@
+package kafka_rating
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+const (
+	dlqHeaderPrefix    = "x-dlq-"
+	dlqHeaderReason    = dlqHeaderPrefix + "reason"
+	dlqHeaderError     = dlqHeaderPrefix + "error"
+	dlqHeaderTopic     = dlqHeaderPrefix + "original-topic"
+	dlqHeaderPartition = dlqHeaderPrefix + "original-partition"
+	dlqHeaderOffset    = dlqHeaderPrefix + "original-offset"
+
+	DLQReasonDecode     = "decode"
+	DLQReasonValidation = "validation"
+	DLQReasonHandler    = "handler"
+	// DLQReasonEmbargo - оценка игры до её выхода; переотправка имеет смысл после release_at
+	DLQReasonEmbargo = "embargo"
+	// DLQReasonRateLimited - превышен лимит пользователя; переотправка - через replay-dlq
+	DLQReasonRateLimited = "rate_limited"
+
+	// если в DLQ столько времени нет новых сообщений, считаем, что перечитали всё
+	_replayIdleTimeout = 5 * time.Second
+)
+
+// DLQ - топик для сообщений, которые consumer не смог обработать.
+type DLQ struct {
+	writer *kafka.Writer
+}
+
+// NewDLQ возвращает nil, если TopicDLQ не задан; методы nil-DLQ ничего не делают.
+func NewDLQ(cfg config.KafkaConfig) *DLQ {
+	if cfg.TopicDLQ == "" {
+		return nil
+	}
+	return &DLQ{writer: &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Topic:        cfg.TopicDLQ,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}}
+}
+
+func (d *DLQ) Publish(ctx context.Context, m kafka.Message, reason string, cause error) error {
+	if d == nil {
+		return nil
+	}
+
+	headers := append(stripDLQHeaders(m.Headers),
+		kafka.Header{Key: dlqHeaderReason, Value: []byte(reason)},
+		kafka.Header{Key: dlqHeaderTopic, Value: []byte(m.Topic)},
+		kafka.Header{Key: dlqHeaderPartition, Value: []byte(strconv.Itoa(m.Partition))},
+		kafka.Header{Key: dlqHeaderOffset, Value: []byte(strconv.FormatInt(m.Offset, 10))},
+	)
+	if cause != nil {
+		headers = append(headers, kafka.Header{Key: dlqHeaderError, Value: []byte(cause.Error())})
+	}
+
+	return d.writer.WriteMessages(ctx, kafka.Message{Key: m.Key, Value: m.Value, Headers: headers})
+}
+
+func (d *DLQ) Close() error {
+	if d == nil {
+		return nil
+	}
+	return d.writer.Close()
+}
+
+// ReplayDLQ перекладывает сообщения из DLQ обратно в топик оценок.
+// limit <= 0 - пока DLQ не опустеет.
+func ReplayDLQ(ctx context.Context, cfg config.KafkaConfig, limit int, logger *zap.Logger) (int, error) {
+	if cfg.TopicDLQ == "" {
+		return 0, errors.New("kafka.topic_dlq is not configured")
+	}
+
+	logger = logger.With(zap.String("component", "dlq-replay"))
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     cfg.Brokers,
+		GroupID:     cfg.GroupID + "-dlq-replay",
+		Topic:       cfg.TopicDLQ,
+		MinBytes:    cfg.MinBytes,
+		MaxBytes:    cfg.MaxBytes,
+		MaxWait:     cfg.MaxWait,
+		StartOffset: kafka.FirstOffset,
+		Dialer:      &kafka.Dialer{Timeout: cfg.DialTimeout, DualStack: true},
+	})
+	defer reader.Close()
+
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Topic:        cfg.TopicRatings,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+	defer writer.Close()
+
+	replayed := 0
+	for limit <= 0 || replayed < limit {
+		fetchCtx, cancel := context.WithTimeout(ctx, _replayIdleTimeout)
+		m, err := reader.FetchMessage(fetchCtx)
+		cancel()
+		if err != nil {
+			if ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+				break
+			}
+			return replayed, fmt.Errorf("fetch from dlq: %w", err)
+		}
+
+		out := kafka.Message{Key: m.Key, Value: m.Value, Headers: stripDLQHeaders(m.Headers)}
+		if err := writer.WriteMessages(ctx, out); err != nil {
+			return replayed, fmt.Errorf("republish offset %d: %w", m.Offset, err)
+		}
+		if err := reader.CommitMessages(ctx, m); err != nil {
+			return replayed, fmt.Errorf("commit dlq offset %d: %w", m.Offset, err)
+		}
+
+		replayed++
+		logger.Info("message replayed",
+			zap.Int64("dlq_offset", m.Offset),
+			zap.String("reason", headerCarrier(m.Headers).Get(dlqHeaderReason)))
+	}
+
+	return replayed, nil
+}
+
+func stripDLQHeaders(headers []kafka.Header) []kafka.Header {
+	out := make([]kafka.Header, 0, len(headers))
+	for _, h := range headers {
+		if !strings.HasPrefix(h.Key, dlqHeaderPrefix) {
+			out = append(out, h)
+		}
+	}
+	return out
+}
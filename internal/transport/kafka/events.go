@@ -0,0 +1,190 @@
+package kafka_rating
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/metrics"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// EventHandler обрабатывает одно JSON-сообщение топика. *entity.ValidationError уводит
+// сообщение в DLQ с причиной validation, любая другая ошибка - с причиной handler.
+type EventHandler[T any] func(ctx context.Context, msg T) error
+
+// EventConsumer - consumer вспомогательных топиков (события аккаунтов, каталог и т.п.):
+// то же, что Consumer, но без rate limit'а и с произвольным обработчиком.
+type EventConsumer[T any] struct {
+	name    string
+	reader  *kafka.Reader
+	dlq     *DLQ
+	handle  EventHandler[T]
+	metrics *metrics.Metrics
+	logger  *zap.Logger
+
+	mu       sync.Mutex
+	fetchErr error
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewEventConsumer читает topic отдельной consumer group "<group_id>-<name>",
+// чтобы смещения не смешивались с топиком оценок.
+func NewEventConsumer[T any](cfg config.KafkaConfig, name, topic string, handle EventHandler[T], m *metrics.Metrics,
+	logger *zap.Logger) *EventConsumer[T] {
+
+	startOffset := kafka.FirstOffset
+	if cfg.StartOffset == "last" {
+		startOffset = kafka.LastOffset
+	}
+
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:          cfg.Brokers,
+		GroupID:          cfg.GroupID + "-" + name,
+		Topic:            topic,
+		MinBytes:         cfg.MinBytes,
+		MaxBytes:         cfg.MaxBytes,
+		MaxWait:          cfg.MaxWait,
+		ReadBatchTimeout: cfg.ReadTimeout,
+		CommitInterval:   cfg.CommitInterval,
+		StartOffset:      startOffset,
+		Dialer: &kafka.Dialer{
+			Timeout:   cfg.DialTimeout,
+			DualStack: true,
+		},
+	})
+	return &EventConsumer[T]{
+		name:    name,
+		reader:  r,
+		dlq:     NewDLQ(cfg),
+		handle:  handle,
+		metrics: m,
+		logger:  logger.With(zap.String("component", "kafka-"+name)),
+	}
+}
+
+func (c *EventConsumer[T]) Name() string { return "kafka-" + c.name }
+
+func (c *EventConsumer[T]) Start(ctx context.Context) error {
+	ctx, c.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	c.done = make(chan struct{})
+
+	go func() {
+		defer close(c.done)
+		c.run(ctx)
+	}()
+
+	return nil
+}
+
+func (c *EventConsumer[T]) Stop(ctx context.Context) error {
+	c.cancel()
+
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		c.logger.Warn("consumer did not drain before deadline")
+	}
+
+	return errors.Join(c.reader.Close(), c.dlq.Close())
+}
+
+func (c *EventConsumer[T]) run(ctx context.Context) {
+	c.logger.Info("starting kafka consumer loop", zap.String("topic", c.reader.Config().Topic))
+	for {
+		m, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				c.logger.Info("context done, exiting consumer")
+				return
+			}
+			c.logger.Error("fetch message failed", zap.Error(err))
+			c.metrics.ConsumerErrors.WithLabelValues("fetch").Inc()
+			c.setFetchErr(err)
+			continue
+		}
+		c.setFetchErr(nil)
+
+		c.process(context.WithoutCancel(ctx), m)
+	}
+}
+
+// process фиксирует смещение после обработки или публикации в DLQ; если DLQ недоступна,
+// сообщение остаётся незафиксированным.
+func (c *EventConsumer[T]) process(ctx context.Context, m kafka.Message) {
+	ctx, span := startMessageSpan(ctx, m)
+	defer span.End()
+
+	start := time.Now()
+	defer func() { c.metrics.ConsumerProcessing.Observe(time.Since(start).Seconds()) }()
+	c.metrics.SetLag(m.Topic, m.Partition, m.HighWaterMark-m.Offset-1)
+
+	logger := tracing.Logger(ctx, c.logger)
+
+	if !c.apply(ctx, logger, m) {
+		return
+	}
+	if err := c.reader.CommitMessages(ctx, m); err != nil {
+		logger.Warn("commit failed", zap.Error(err))
+		c.metrics.ConsumerErrors.WithLabelValues("commit").Inc()
+	}
+}
+
+func (c *EventConsumer[T]) apply(ctx context.Context, logger *zap.Logger, m kafka.Message) bool {
+	var msg T
+	if err := json.Unmarshal(m.Value, &msg); err != nil {
+		logger.Error("invalid message, skipping", zap.Error(err))
+		c.metrics.ConsumerErrors.WithLabelValues("decode").Inc()
+		return c.toDLQ(ctx, logger, m, DLQReasonDecode, err)
+	}
+
+	err := c.handle(ctx, msg)
+	if err == nil {
+		return true
+	}
+
+	trace.SpanFromContext(ctx).RecordError(err)
+	c.metrics.ConsumerErrors.WithLabelValues("handler").Inc()
+	var verr *entity.ValidationError
+	if errors.As(err, &verr) {
+		logger.Warn("message rejected by validation", zap.Error(err))
+		return c.toDLQ(ctx, logger, m, DLQReasonValidation, err)
+	}
+	logger.Error("handler error", zap.Error(err))
+	return c.toDLQ(ctx, logger, m, DLQReasonHandler, err)
+}
+
+func (c *EventConsumer[T]) toDLQ(ctx context.Context, logger *zap.Logger, m kafka.Message, reason string, cause error) bool {
+	if err := c.dlq.Publish(ctx, m, reason, cause); err != nil {
+		logger.Error("publish to dlq failed, message left uncommitted", zap.String("reason", reason), zap.Error(err))
+		c.metrics.ConsumerErrors.WithLabelValues("dlq").Inc()
+		return false
+	}
+	return true
+}
+
+func (c *EventConsumer[T]) setFetchErr(err error) {
+	c.mu.Lock()
+	c.fetchErr = err
+	c.mu.Unlock()
+}
+
+// Health - ошибка последнего FetchMessage, если после неё не было успешного чтения.
+func (c *EventConsumer[T]) Health(_ context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fetchErr != nil {
+		return fmt.Errorf("kafka %s fetch failed: %w", c.name, c.fetchErr)
+	}
+	return nil
+}
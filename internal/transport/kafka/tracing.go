@@ -0,0 +1,52 @@
+package kafka_rating
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/RozmiDan/gameReviewHubRating/internal/transport/kafka")
+
+// headerCarrier - propagation.TextMapCarrier поверх заголовков сообщения Kafka.
+type headerCarrier []kafka.Header
+
+func (c headerCarrier) Get(key string) string {
+	for _, h := range c {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c headerCarrier) Set(string, string) {}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for _, h := range c {
+		keys = append(keys, h.Key)
+	}
+	return keys
+}
+
+var _ propagation.TextMapCarrier = headerCarrier(nil)
+
+// startMessageSpan продолжает трейс продюсера из заголовков сообщения.
+func startMessageSpan(ctx context.Context, m kafka.Message) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, headerCarrier(m.Headers))
+	return tracer.Start(ctx, m.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(m.Topic),
+			semconv.MessagingKafkaMessageOffset(int(m.Offset)),
+			attribute.Int("messaging.kafka.partition", m.Partition),
+		),
+	)
+}
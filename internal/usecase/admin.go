@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"go.uber.org/zap"
+)
+
+type AdminRepository interface {
+	ReconcileAggregatesRepo(ctx context.Context) (int64, error)
+	RecomputeGameRepo(ctx context.Context, gameID string) error
+	DeleteUserRatingsRepo(ctx context.Context, userID string) ([]string, error)
+	GetDistributionRepo(ctx context.Context, gameID string) (entity.Distribution, error)
+}
+
+// adminService - операции обслуживания, которые не доступны обычным клиентам.
+type adminService struct {
+	repo      AdminRepository
+	validator *Validator
+	logger    *zap.Logger
+}
+
+func NewAdminService(repository AdminRepository, validator *Validator, logger *zap.Logger) *adminService {
+	logger = logger.With(zap.String("layer", "adminService"))
+	return &adminService{repo: repository, validator: validator, logger: logger}
+}
+
+func (s *adminService) ReconcileAggregates(ctx context.Context) (int64, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "ReconcileAggregates"))
+
+	fixed, err := s.repo.ReconcileAggregatesRepo(ctx)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return 0, err
+	}
+
+	logger.Info("aggregates reconciled", zap.Int64("fixed", fixed))
+
+	return fixed, nil
+}
+
+func (s *adminService) RecomputeGame(ctx context.Context, gameID string) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "RecomputeGame"))
+
+	if err := s.validator.ValidateGameID(gameID, entity.ScaleTen); err != nil {
+		return err
+	}
+
+	if err := s.repo.RecomputeGameRepo(ctx, gameID); err != nil {
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *adminService) DeleteUserRatings(ctx context.Context, userID string) ([]string, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "DeleteUserRatings"))
+
+	if err := s.validator.ValidateUserID(userID); err != nil {
+		return nil, err
+	}
+
+	games, err := s.repo.DeleteUserRatingsRepo(ctx, userID)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("user ratings deleted", zap.String("user_id", userID), zap.Int("games", len(games)))
+
+	return games, nil
+}
+
+func (s *adminService) GetDistribution(ctx context.Context, gameID string) (entity.Distribution, error) {
+	if err := s.validator.ValidateGameID(gameID, entity.ScaleTen); err != nil {
+		return entity.Distribution{}, err
+	}
+
+	return s.repo.GetDistributionRepo(ctx, gameID)
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type CatalogRepository interface {
+	ApplyCatalogEventRepo(ctx context.Context, meta entity.GameMeta) (bool, error)
+}
+
+type catalogService struct {
+	repo      CatalogRepository
+	validator *Validator
+	logger    *zap.Logger
+}
+
+func NewCatalogService(repository CatalogRepository, validator *Validator, logger *zap.Logger) *catalogService {
+	logger = logger.With(zap.String("layer", "catalogService"))
+	return &catalogService{
+		repo:      repository,
+		validator: validator,
+		logger:    logger,
+	}
+}
+
+// HandleCatalogEvent обновляет проекцию каталога. Жанр и теги приводятся к нижнему
+// регистру, чтобы фильтры топа не зависели от написания в каталоге.
+func (s *catalogService) HandleCatalogEvent(ctx context.Context, msg entity.CatalogMessage) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "HandleCatalogEvent"))
+
+	if err := s.validator.ValidateCatalogEvent(msg); err != nil {
+		return err
+	}
+
+	meta := entity.GameMeta{
+		GameID:    uuid.MustParse(msg.GameID).String(),
+		Deleted:   entity.CatalogEventType(msg.Type) == entity.CatalogDeleted,
+		UpdatedAt: time.Now(),
+	}
+	if msg.UpdatedAt != nil {
+		meta.UpdatedAt = *msg.UpdatedAt
+	}
+	if !meta.Deleted {
+		meta.Genre = normalizeTag(msg.Genre)
+		meta.Tags = normalizeTags(msg.Tags)
+		meta.ReleaseYear = msg.ReleaseYear
+		meta.Publisher = strings.TrimSpace(msg.Publisher)
+		meta.Developer = strings.TrimSpace(msg.Developer)
+	}
+
+	applied, err := s.repo.ApplyCatalogEventRepo(ctx, meta)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+	if !applied {
+		logger.Info("stale catalog event skipped", zap.String("game_id", meta.GameID))
+		return nil
+	}
+
+	logger.Info("catalog event applied", zap.String("game_id", meta.GameID), zap.String("type", msg.Type))
+
+	return nil
+}
+
+// normalizeTopFilter приводит фильтр к виду, в котором хранится проекция каталога.
+func normalizeTopFilter(f entity.TopFilter) entity.TopFilter {
+	f.Genre = normalizeTag(f.Genre)
+	f.Tags = normalizeTags(f.Tags)
+	f.Publisher = strings.TrimSpace(f.Publisher)
+	return f
+}
+
+func normalizeTag(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// normalizeTags - теги в нижнем регистре, без пустых и повторов.
+func normalizeTags(tags []string) []string {
+	var out []string
+	for _, t := range tags {
+		if t = normalizeTag(t); t != "" && !slices.Contains(out, t) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// _maxFilterTags - сколько тегов можно указать в фильтре топа.
+const _maxFilterTags = 10
+
+func (v *Validator) ValidateTopFilter(filter entity.TopFilter) error {
+	verr := &entity.ValidationError{}
+
+	if len(filter.Tags) > _maxFilterTags {
+		verr.Add("tags", entity.ErrInvalidArgument, fmt.Sprintf("at most %d tags are allowed", _maxFilterTags))
+	}
+	if filter.ReleaseYear < 0 {
+		verr.Add("release_year", entity.ErrInvalidArgument, "release_year must be positive")
+	}
+
+	return verr.OrNil()
+}
+
+func (v *Validator) ValidateCatalogEvent(msg entity.CatalogMessage) error {
+	verr := &entity.ValidationError{}
+
+	validateUUID(verr, "game_id", msg.GameID)
+	if _, err := entity.ParseCatalogEventType(msg.Type); err != nil {
+		verr.Add("type", entity.ErrInvalidArgument, err.Error())
+	}
+	if msg.ReleaseYear < 0 {
+		verr.Add("release_year", entity.ErrInvalidArgument, "release_year must be positive")
+	}
+	for _, tag := range msg.Tags {
+		if strings.TrimSpace(tag) == "" {
+			verr.Add("tags", entity.ErrInvalidArgument, "tags must not be empty")
+			break
+		}
+	}
+
+	return verr.OrNil()
+}
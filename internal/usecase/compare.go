@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"math"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/compare"
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type CompareRepository interface {
+	GetGameScoresRepo(ctx context.Context, ids []string) ([]entity.GameScores, error)
+}
+
+type compareService struct {
+	repo      CompareRepository
+	validator *Validator
+	maxGames  int
+	params    entity.CompareParams
+	logger    *zap.Logger
+}
+
+func NewCompareService(repository CompareRepository, validator *Validator, cfg config.CompareConfig,
+	logger *zap.Logger) *compareService {
+
+	logger = logger.With(zap.String("layer", "compareService"))
+	return &compareService{
+		repo:      repository,
+		validator: validator,
+		maxGames:  cfg.MaxGames,
+		params:    entity.CompareParams{Confidence: cfg.Confidence, Alpha: cfg.Alpha},
+		logger:    logger,
+	}
+}
+
+// CompareGames - сводка по каждой игре и попарные различия их оценок (U-критерий
+// Манна-Уитни с поправкой Холма на число пар). Все игры должны иметь оценки, иначе
+// entity.ErrGameNotFound. Замороженные игры отдаются по снимку с Frozen и в пары
+// не входят: их текущие оценки скрыты до разморозки.
+func (s *compareService) CompareGames(ctx context.Context, gameIDs []string, scale entity.Scale) (entity.Comparison, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "CompareGames"))
+
+	scale = readScale(scale)
+	if err := s.validator.ValidateCompare(gameIDs, s.maxGames, scale); err != nil {
+		return entity.Comparison{}, err
+	}
+
+	ids := make([]string, 0, len(gameIDs))
+	for _, id := range gameIDs {
+		ids = append(ids, uuid.MustParse(id).String())
+	}
+
+	games, err := s.repo.GetGameScoresRepo(ctx, ids)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return entity.Comparison{}, err
+	}
+	if len(games) != len(ids) {
+		logger.Info("some games have no ratings", zap.Int("requested", len(ids)), zap.Int("found", len(games)))
+		return entity.Comparison{}, entity.ErrGameNotFound
+	}
+
+	res := entity.Comparison{Params: s.params, Scale: scale}
+	means := make([]float64, len(games))
+	var live []int
+	for i, g := range games {
+		if g.Frozen {
+			res.Games = append(res.Games, entity.GameComparison{
+				GameID:        g.GameID,
+				AverageRating: scale.Project(g.AverageRating),
+				RatingsCount:  g.RatingsCount,
+				Rank:          g.Rank,
+				Frozen:        true,
+			})
+			continue
+		}
+		live = append(live, i)
+
+		n, mean, _ := compare.Summary(g.Scores)
+		lo, hi := compare.MeanCI(g.Scores, s.params.Confidence)
+		means[i] = mean
+		res.Games = append(res.Games, entity.GameComparison{
+			GameID:        g.GameID,
+			AverageRating: scale.Project(mean),
+			RatingsCount:  n,
+			Distribution:  compare.Distribution(g.Scores),
+			Rank:          g.Rank,
+			CILow:         scale.Project(lo),
+			CIHigh:        scale.Project(hi),
+		})
+	}
+
+	var pvalues []float64
+	for a, i := range live {
+		for _, j := range live[a+1:] {
+			mw := compare.MannWhitney(games[i].Scores, games[j].Scores)
+			res.Pairs = append(res.Pairs, entity.PairComparison{
+				GameA:           games[i].GameID,
+				GameB:           games[j].GameID,
+				MeanDifference:  math.Round((scale.Project(means[i])-scale.Project(means[j]))*100) / 100,
+				U:               mw.U,
+				Z:               mw.Z,
+				ProbSuperiority: mw.ProbSuperiority,
+				PValue:          mw.PValue,
+			})
+			pvalues = append(pvalues, mw.PValue)
+		}
+	}
+	for i, p := range compare.Holm(pvalues) {
+		res.Pairs[i].PAdjusted = p
+		res.Pairs[i].Significant = p < s.params.Alpha
+	}
+
+	logger.Info("games compared", zap.Int("games", len(res.Games)), zap.Int("pairs", len(res.Pairs)))
+
+	return res, nil
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/google/uuid"
+)
+
+func (v *Validator) ValidateCompare(gameIDs []string, maxGames int, scale entity.Scale) error {
+	verr := &entity.ValidationError{}
+
+	if len(gameIDs) < 2 || len(gameIDs) > maxGames {
+		verr.Add("game_ids", entity.ErrInvalidArgument, fmt.Sprintf("between 2 and %d games are required", maxGames))
+	}
+	seen := make(map[string]bool, len(gameIDs))
+	for i, id := range gameIDs {
+		field := fmt.Sprintf("game_ids[%d]", i)
+		if !validateUUID(verr, field, id) {
+			continue
+		}
+		id = uuid.MustParse(id).String()
+		if seen[id] {
+			verr.Add(field, entity.ErrInvalidArgument, "duplicate game_id")
+		}
+		seen[id] = true
+	}
+	validateKnownScale(verr, scale)
+
+	return verr.OrNil()
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type EmbargoRepository interface {
+	SetReleaseRepo(ctx context.Context, rel entity.GameRelease) (entity.GameRelease, error)
+	DeleteReleaseRepo(ctx context.Context, gameID string) error
+	ListReleasesRepo(ctx context.Context) ([]entity.GameRelease, error)
+	ReleaseParkedRepo(ctx context.Context) (int64, error)
+	GrantExemptionRepo(ctx context.Context, e entity.EmbargoExemption) (entity.EmbargoExemption, error)
+	RevokeExemptionRepo(ctx context.Context, userID string) error
+	ListExemptionsRepo(ctx context.Context) ([]entity.EmbargoExemption, error)
+}
+
+// embargoService - даты выхода игр и отложенные до выхода оценки. Сама проверка
+// при приёме оценки - в ratingService.SubmitRating.
+type embargoService struct {
+	repo      EmbargoRepository
+	validator *Validator
+	logger    *zap.Logger
+}
+
+func NewEmbargoService(repository EmbargoRepository, validator *Validator, logger *zap.Logger) *embargoService {
+	logger = logger.With(zap.String("layer", "embargoService"))
+	return &embargoService{repo: repository, validator: validator, logger: logger}
+}
+
+// SetGameRelease задаёт дату выхода и политику игры; пустая политика - из конфига.
+func (s *embargoService) SetGameRelease(ctx context.Context, rel entity.GameRelease) (entity.GameRelease, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "SetGameRelease"))
+
+	if err := s.validator.ValidateRelease(rel); err != nil {
+		return entity.GameRelease{}, err
+	}
+	rel.GameID = uuid.MustParse(rel.GameID).String()
+
+	rel, err := s.repo.SetReleaseRepo(ctx, rel)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return entity.GameRelease{}, err
+	}
+
+	return rel, nil
+}
+
+// DeleteGameRelease снимает эмбарго; отложенные оценки попадут в рейтинг с ближайшим ReleaseParked.
+func (s *embargoService) DeleteGameRelease(ctx context.Context, gameID string) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "DeleteGameRelease"))
+
+	if err := s.validator.ValidateGameID(gameID, entity.ScaleTen); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteReleaseRepo(ctx, uuid.MustParse(gameID).String()); err != nil {
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *embargoService) ListUpcomingReleases(ctx context.Context) ([]entity.GameRelease, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "ListUpcomingReleases"))
+
+	releases, err := s.repo.ListReleasesRepo(ctx)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return nil, err
+	}
+
+	return releases, nil
+}
+
+// ReleaseParked переносит в рейтинг отложенные оценки вышедших игр. Вызывается периодически.
+func (s *embargoService) ReleaseParked(ctx context.Context) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "ReleaseParked"))
+
+	released, err := s.repo.ReleaseParkedRepo(ctx)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+
+	if released > 0 {
+		logger.Info("parked ratings released", zap.Int64("ratings", released))
+	}
+
+	return nil
+}
+
+// GrantEmbargoExemption разрешает пользователю оценивать игры до выхода. Исключения
+// ведутся только здесь: роли, присланные клиентом, SubmitRating не учитывает.
+func (s *embargoService) GrantEmbargoExemption(ctx context.Context, e entity.EmbargoExemption) (entity.EmbargoExemption, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "GrantEmbargoExemption"))
+
+	if err := s.validator.ValidateExemption(e); err != nil {
+		return entity.EmbargoExemption{}, err
+	}
+	e.UserID = uuid.MustParse(e.UserID).String()
+
+	e, err := s.repo.GrantExemptionRepo(ctx, e)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return entity.EmbargoExemption{}, err
+	}
+
+	return e, nil
+}
+
+func (s *embargoService) RevokeEmbargoExemption(ctx context.Context, userID string) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "RevokeEmbargoExemption"))
+
+	if err := s.validator.ValidateUserID(userID); err != nil {
+		return err
+	}
+
+	if err := s.repo.RevokeExemptionRepo(ctx, uuid.MustParse(userID).String()); err != nil {
+		if errors.Is(err, entity.ErrNotExempt) {
+			logger.Info("user is not exempt", zap.String("user_id", userID))
+			return err
+		}
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *embargoService) ListEmbargoExemptions(ctx context.Context) ([]entity.EmbargoExemption, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "ListEmbargoExemptions"))
+
+	users, err := s.repo.ListExemptionsRepo(ctx)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return nil, err
+	}
+
+	return users, nil
+}
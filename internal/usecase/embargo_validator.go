@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+func (v *Validator) ValidateExemption(e entity.EmbargoExemption) error {
+	verr := &entity.ValidationError{}
+
+	validateUUID(verr, "user_id", e.UserID)
+	if strings.TrimSpace(e.GrantedBy) == "" {
+		verr.Add("granted_by", entity.ErrRequired, "granted_by is required")
+	}
+	if strings.TrimSpace(e.Reason) == "" {
+		verr.Add("reason", entity.ErrRequired, "reason is required")
+	}
+
+	return verr.OrNil()
+}
+
+func (v *Validator) ValidateRelease(rel entity.GameRelease) error {
+	verr := &entity.ValidationError{}
+
+	validateUUID(verr, "game_id", rel.GameID)
+	if rel.ReleaseAt.IsZero() {
+		verr.Add("release_at", entity.ErrRequired, "release_at is required")
+	}
+	if rel.Policy != "" {
+		if _, err := entity.ParseEmbargoPolicy(string(rel.Policy)); err != nil {
+			verr.Add("policy", entity.ErrInvalidArgument, err.Error())
+		}
+	}
+
+	return verr.OrNil()
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"go.uber.org/zap"
+)
+
+type ExportRepository interface {
+	ExportRepo(ctx context.Context, filter entity.ExportFilter,
+		onRating func(entity.ExportRating) error, onGame func(entity.ExportGameRating) error) error
+}
+
+type exportService struct {
+	repo      ExportRepository
+	validator *Validator
+	logger    *zap.Logger
+}
+
+func NewExportService(repository ExportRepository, validator *Validator, logger *zap.Logger) *exportService {
+	logger = logger.With(zap.String("layer", "exportService"))
+	return &exportService{repo: repository, validator: validator, logger: logger}
+}
+
+func (s *exportService) Export(ctx context.Context, filter entity.ExportFilter, sink entity.ExportSink) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "Export"))
+
+	if len(filter.Datasets) == 0 {
+		filter.Datasets = []entity.Dataset{entity.DatasetRatings, entity.DatasetGameRatings}
+	}
+	if err := s.validator.ValidateExport(filter); err != nil {
+		return err
+	}
+
+	var ratings, games int64
+	t := &datasetSwitch{sink: sink, begun: make(map[entity.Dataset]bool)}
+	err := s.repo.ExportRepo(ctx, filter,
+		func(r entity.ExportRating) error {
+			ratings++
+			if err := t.to(entity.DatasetRatings); err != nil {
+				return err
+			}
+			return sink.WriteRating(r)
+		},
+		func(g entity.ExportGameRating) error {
+			games++
+			if err := t.to(entity.DatasetGameRatings); err != nil {
+				return err
+			}
+			return sink.WriteGameRating(g)
+		},
+	)
+	if err == nil {
+		err = t.finish(filter.Datasets)
+	}
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+
+	logger.Info("export finished",
+		zap.Any("datasets", filter.Datasets),
+		zap.Int64("ratings", ratings),
+		zap.Int64("game_ratings", games),
+	)
+
+	return nil
+}
+
+// datasetSwitch вызывает Begin/End у sink при смене набора данных в потоке строк.
+type datasetSwitch struct {
+	sink    entity.ExportSink
+	current entity.Dataset
+	begun   map[entity.Dataset]bool
+}
+
+func (t *datasetSwitch) to(ds entity.Dataset) error {
+	if t.current == ds {
+		return nil
+	}
+	if t.current != "" {
+		if err := t.sink.End(t.current); err != nil {
+			return err
+		}
+	}
+	t.current = ds
+	t.begun[ds] = true
+	return t.sink.Begin(ds)
+}
+
+// finish закрывает текущий набор и выдаёт пустые наборы, в которых не нашлось строк.
+func (t *datasetSwitch) finish(datasets []entity.Dataset) error {
+	for _, ds := range datasets {
+		if !t.begun[ds] {
+			if err := t.to(ds); err != nil {
+				return err
+			}
+		}
+	}
+	if t.current == "" {
+		return nil
+	}
+	return t.sink.End(t.current)
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// ValidateExport проверяет фильтр выгрузки; пустой Datasets означает оба набора.
+func (v *Validator) ValidateExport(filter entity.ExportFilter) error {
+	verr := &entity.ValidationError{}
+
+	for _, ds := range filter.Datasets {
+		if ds != entity.DatasetRatings && ds != entity.DatasetGameRatings {
+			verr.Add("datasets", entity.ErrInvalidArgument, fmt.Sprintf("unknown dataset %q", ds))
+		}
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		verr.Add("to", entity.ErrInvalidArgument, "to must be after from")
+	}
+	for _, id := range filter.GameIDs {
+		if !validateUUID(verr, "game_ids", id) {
+			break
+		}
+	}
+
+	return verr.OrNil()
+}
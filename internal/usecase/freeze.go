@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type FreezeRepository interface {
+	FreezeGameRepo(ctx context.Context, f entity.GameFreeze) (entity.GameFreeze, error)
+	UnfreezeGameRepo(ctx context.Context, gameID string) (entity.GameFreeze, error)
+	ExpireFreezesRepo(ctx context.Context) ([]string, error)
+	ListFreezesRepo(ctx context.Context) ([]entity.GameFreeze, error)
+}
+
+// freezeService - заморозка публичного рейтинга игр на время разбирательств
+// (review bombing и т.п.): оценки принимаются, но рейтинг не двигается.
+type freezeService struct {
+	repo      FreezeRepository
+	validator *Validator
+	logger    *zap.Logger
+}
+
+func NewFreezeService(repository FreezeRepository, validator *Validator, logger *zap.Logger) *freezeService {
+	logger = logger.With(zap.String("layer", "freezeService"))
+	return &freezeService{repo: repository, validator: validator, logger: logger}
+}
+
+// FreezeGame замораживает рейтинг игры; ttl == 0 - до ручного UnfreezeGame.
+func (s *freezeService) FreezeGame(ctx context.Context, gameID, moderator, reason string, ttl time.Duration) (entity.GameFreeze, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "FreezeGame"))
+
+	if err := s.validator.ValidateFreeze(gameID, moderator, reason, ttl); err != nil {
+		return entity.GameFreeze{}, err
+	}
+
+	f := entity.GameFreeze{
+		GameID:    uuid.MustParse(gameID).String(),
+		Moderator: strings.TrimSpace(moderator),
+		Reason:    strings.TrimSpace(reason),
+	}
+	if ttl > 0 {
+		f.ExpiresAt = time.Now().Add(ttl)
+	}
+
+	f, err := s.repo.FreezeGameRepo(ctx, f)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return entity.GameFreeze{}, err
+	}
+
+	return f, nil
+}
+
+// UnfreezeGame снимает заморозку: рейтинг сразу становится текущим со всеми оценками,
+// накопленными за время заморозки.
+func (s *freezeService) UnfreezeGame(ctx context.Context, gameID, moderator, reason string) (entity.GameFreeze, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "UnfreezeGame"))
+
+	if err := s.validator.ValidateFreeze(gameID, moderator, reason, 0); err != nil {
+		return entity.GameFreeze{}, err
+	}
+
+	f, err := s.repo.UnfreezeGameRepo(ctx, uuid.MustParse(gameID).String())
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return entity.GameFreeze{}, err
+	}
+
+	logger.Info("game unfrozen",
+		zap.String("game_id", f.GameID),
+		zap.String("moderator", strings.TrimSpace(moderator)),
+		zap.String("reason", strings.TrimSpace(reason)),
+		zap.String("frozen_by", f.Moderator),
+	)
+
+	return f, nil
+}
+
+func (s *freezeService) ListFrozenGames(ctx context.Context) ([]entity.GameFreeze, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "ListFrozenGames"))
+
+	freezes, err := s.repo.ListFreezesRepo(ctx)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return nil, err
+	}
+
+	return freezes, nil
+}
+
+// ExpireFreezes убирает истёкшие заморозки. Вызывается периодически.
+func (s *freezeService) ExpireFreezes(ctx context.Context) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "ExpireFreezes"))
+
+	games, err := s.repo.ExpireFreezesRepo(ctx)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+
+	if len(games) > 0 {
+		logger.Info("freezes expired", zap.Strings("games", games))
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+func (v *Validator) ValidateFreeze(gameID, moderator, reason string, ttl time.Duration) error {
+	verr := &entity.ValidationError{}
+
+	validateUUID(verr, "game_id", gameID)
+	if strings.TrimSpace(moderator) == "" {
+		verr.Add("moderator", entity.ErrRequired, "moderator is required")
+	}
+	if strings.TrimSpace(reason) == "" {
+		verr.Add("reason", entity.ErrRequired, "reason is required")
+	}
+	if ttl < 0 {
+		verr.Add("ttl", entity.ErrInvalidArgument, "ttl must not be negative")
+	}
+
+	return verr.OrNil()
+}
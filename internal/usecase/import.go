@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ImportRepository interface {
+	// ImportRatingsRepo загружает строки одним проходом; при dryRun транзакция откатывается.
+	// embargoPolicy применяется к неизданным играм без своей политики.
+	ImportRatingsRepo(ctx context.Context, rows []entity.ImportRow, dryRun bool,
+		embargoPolicy entity.EmbargoPolicy) (entity.ImportStats, error)
+}
+
+// RecordSource - поток записей файла импорта (см. bulk.Decoder).
+type RecordSource interface {
+	Next() (entity.ImportRecord, error)
+}
+
+type importService struct {
+	repo          ImportRepository
+	validator     *Validator
+	embargoPolicy entity.EmbargoPolicy
+	logger        *zap.Logger
+}
+
+func NewImportService(repository ImportRepository, validator *Validator, embargo config.EmbargoConfig,
+	logger *zap.Logger) (*importService, error) {
+	policy, err := entity.ParseEmbargoPolicy(embargo.DefaultPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("usecase - NewImportService: %w", err)
+	}
+
+	logger = logger.With(zap.String("layer", "importService"))
+	return &importService{repo: repository, validator: validator, embargoPolicy: policy, logger: logger}, nil
+}
+
+// Import валидирует все записи источника и загружает прошедшие одним батчем.
+// Отклонённые строки не прерывают импорт и возвращаются в ImportResult.Rejected,
+// туда же попадают строки неизданных игр, отклонённые эмбарго.
+func (s *importService) Import(ctx context.Context, src RecordSource, dryRun bool) (entity.ImportResult, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "Import"), zap.Bool("dry_run", dryRun))
+
+	res := entity.ImportResult{DryRun: dryRun}
+	now := time.Now()
+
+	var rows []entity.ImportRow
+	seen := make(map[string]int)
+
+	for {
+		rec, err := src.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var lineErr *entity.LineError
+		if errors.As(err, &lineErr) {
+			res.Total++
+			res.Rejected = append(res.Rejected, lineErr)
+			continue
+		}
+		if err != nil {
+			logger.Error("read failed", zap.Error(err))
+			return res, err
+		}
+		res.Total++
+
+		scale := s.validator.Scale(entity.Scale(rec.Scale))
+		if err := s.validator.ValidateImport(rec, scale, now); err != nil {
+			res.Rejected = append(res.Rejected, &entity.LineError{Line: rec.Line, Err: err})
+			continue
+		}
+
+		row := entity.ImportRow{
+			Line: rec.Line,
+			Rating: entity.Rating{
+				UserID: uuid.MustParse(rec.UserID).String(),
+				GameID: uuid.MustParse(rec.GameID).String(),
+				Value:  rec.Rating,
+				Scale:  scale,
+				Score:  scale.Normalize(rec.Rating),
+			},
+			CreatedAt: rec.CreatedAt,
+		}
+
+		// в одном INSERT ... ON CONFLICT пара не может встретиться дважды - оставляем последнюю строку
+		key := row.Rating.UserID + "/" + row.Rating.GameID
+		if i, ok := seen[key]; ok {
+			rows[i] = row
+			res.Duplicates++
+			continue
+		}
+		seen[key] = len(rows)
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		logger.Info("nothing to import", zap.Int("total", res.Total), zap.Int("rejected", len(res.Rejected)))
+		return res, nil
+	}
+
+	stats, err := s.repo.ImportRatingsRepo(ctx, rows, dryRun, s.embargoPolicy)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return res, err
+	}
+	res.ImportStats = stats
+	res.Rejected = append(res.Rejected, stats.Embargoed...)
+
+	logger.Info("ratings imported",
+		zap.Int("total", res.Total),
+		zap.Int("rejected", len(res.Rejected)),
+		zap.Int("duplicates", res.Duplicates),
+		zap.Int64("inserted", stats.Inserted),
+		zap.Int64("updated", stats.Updated),
+		zap.Int64("parked", stats.Parked),
+		zap.Int64("games", stats.Games),
+	)
+
+	return res, nil
+}
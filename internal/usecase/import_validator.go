@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// ValidateImport - те же проверки, что у SubmitRating, плюс created_at не из будущего.
+func (v *Validator) ValidateImport(rec entity.ImportRecord, scale entity.Scale, now time.Time) error {
+	verr := &entity.ValidationError{}
+	v.validateSubmit(verr, rec.UserID, rec.GameID, rec.Rating, scale)
+	if rec.CreatedAt != nil && rec.CreatedAt.After(now) {
+		verr.Add("created_at", entity.ErrInvalidArgument, "created_at must not be in the future")
+	}
+	return verr.OrNil()
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ModerationRepository interface {
+	ModerateRatingsRepo(ctx context.Context, action entity.ModerationAction) (entity.ModerationAction, error)
+	ListModerationActionsRepo(ctx context.Context, f entity.ModerationFilter) ([]entity.ModerationAction, error)
+}
+
+// defaultModerationPage - размер страницы журнала, если клиент его не задал
+const defaultModerationPage = 50
+
+type moderationService struct {
+	repo        ModerationRepository
+	validator   *Validator
+	maxPageSize int
+	logger      *zap.Logger
+}
+
+func NewModerationService(repository ModerationRepository, validator *Validator, cfg config.ModerationConfig,
+	logger *zap.Logger) *moderationService {
+
+	logger = logger.With(zap.String("layer", "moderationService"))
+	return &moderationService{repo: repository, validator: validator, maxPageSize: cfg.MaxPageSize, logger: logger}
+}
+
+// HideRatings убирает оценки цели из всех агрегатов до UnhideRatings.
+func (s *moderationService) HideRatings(ctx context.Context, target entity.ModerationTarget, moderator, reason string) (entity.ModerationAction, error) {
+	return s.moderate(ctx, entity.ModerationHide, target, moderator, reason)
+}
+
+// UnhideRatings возвращает скрытые оценки цели в агрегаты.
+func (s *moderationService) UnhideRatings(ctx context.Context, target entity.ModerationTarget, moderator, reason string) (entity.ModerationAction, error) {
+	return s.moderate(ctx, entity.ModerationUnhide, target, moderator, reason)
+}
+
+func (s *moderationService) moderate(ctx context.Context, typ entity.ModerationActionType, target entity.ModerationTarget,
+	moderator, reason string) (entity.ModerationAction, error) {
+
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "moderate"))
+
+	action := entity.ModerationAction{
+		Type:      typ,
+		Moderator: strings.TrimSpace(moderator),
+		Reason:    strings.TrimSpace(reason),
+		Target:    target,
+	}
+	if err := s.validator.ValidateModeration(action); err != nil {
+		return entity.ModerationAction{}, err
+	}
+
+	action.Target.UserID = uuid.MustParse(target.UserID).String()
+	if target.GameID != "" {
+		action.Target.GameID = uuid.MustParse(target.GameID).String()
+	}
+
+	action, err := s.repo.ModerateRatingsRepo(ctx, action)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return entity.ModerationAction{}, err
+	}
+
+	logger.Info("moderation action recorded",
+		zap.Int64("action_id", action.ID),
+		zap.String("action", string(action.Type)),
+		zap.String("moderator", action.Moderator),
+		zap.Int64("affected", action.Affected),
+	)
+
+	return action, nil
+}
+
+// ListActions - страница журнала модерации; Limit == 0 - страница по умолчанию.
+// next - BeforeID следующей страницы, 0 - страница последняя.
+func (s *moderationService) ListActions(ctx context.Context, f entity.ModerationFilter) (_ []entity.ModerationAction, next int64, err error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "ListActions"))
+
+	if f.Limit == 0 {
+		f.Limit = min(defaultModerationPage, s.maxPageSize)
+	}
+	if err := s.validator.ValidateModerationFilter(f, s.maxPageSize); err != nil {
+		return nil, 0, err
+	}
+
+	if f.UserID != "" {
+		f.UserID = uuid.MustParse(f.UserID).String()
+	}
+	if f.GameID != "" {
+		f.GameID = uuid.MustParse(f.GameID).String()
+	}
+
+	actions, err := s.repo.ListModerationActionsRepo(ctx, f)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return nil, 0, err
+	}
+
+	if len(actions) == f.Limit {
+		next = actions[len(actions)-1].ID
+	}
+
+	return actions, next, nil
+}
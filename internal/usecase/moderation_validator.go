@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+func (v *Validator) ValidateModeration(action entity.ModerationAction) error {
+	verr := &entity.ValidationError{}
+
+	t := action.Target
+	validateUUID(verr, "user_id", t.UserID)
+	if t.GameID != "" {
+		validateUUID(verr, "game_id", t.GameID)
+	}
+	if !t.From.IsZero() && !t.To.IsZero() && !t.From.Before(t.To) {
+		verr.Add("to", entity.ErrInvalidArgument, "to must be after from")
+	}
+	if strings.TrimSpace(action.Moderator) == "" {
+		verr.Add("moderator", entity.ErrRequired, "moderator is required")
+	}
+	if strings.TrimSpace(action.Reason) == "" {
+		verr.Add("reason", entity.ErrRequired, "reason is required")
+	}
+
+	return verr.OrNil()
+}
+
+func (v *Validator) ValidateModerationFilter(f entity.ModerationFilter, maxPageSize int) error {
+	verr := &entity.ValidationError{}
+
+	if f.UserID != "" {
+		validateUUID(verr, "user_id", f.UserID)
+	}
+	if f.GameID != "" {
+		validateUUID(verr, "game_id", f.GameID)
+	}
+	if f.Limit < 1 || f.Limit > maxPageSize {
+		verr.Add("page_size", entity.ErrInvalidArgument, fmt.Sprintf("page size must be between 1 and %d", maxPageSize))
+	}
+	if f.BeforeID < 0 {
+		verr.Add("page_token", entity.ErrInvalidArgument, "page token is invalid")
+	}
+
+	return verr.OrNil()
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"go.uber.org/zap"
+)
+
+type NormalizeRepository interface {
+	RecomputeNormalizedRepo(ctx context.Context, params entity.NormalizationParams) (int64, error)
+}
+
+type normalizeService struct {
+	repo   NormalizeRepository
+	params entity.NormalizationParams
+	logger *zap.Logger
+}
+
+func NewNormalizeService(repository NormalizeRepository, cfg config.NormalizeConfig, logger *zap.Logger) (*normalizeService, error) {
+	method, err := entity.ParseNormalizationMethod(cfg.Method)
+	if err != nil {
+		return nil, fmt.Errorf("usecase - NewNormalizeService: %w", err)
+	}
+
+	logger = logger.With(zap.String("layer", "normalizeService"))
+	return &normalizeService{
+		repo:   repository,
+		params: entity.NormalizationParams{Method: method, MinUserRatings: cfg.MinUserRatings},
+		logger: logger,
+	}, nil
+}
+
+// Recompute пересчитывает нормализованный рейтинг всех игр. Вызывается периодически:
+// новая оценка сдвигает среднюю пользователя, а с ней - вклад всех его оценок.
+func (s *normalizeService) Recompute(ctx context.Context) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "Recompute"))
+
+	games, err := s.repo.RecomputeNormalizedRepo(ctx, s.params)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+
+	logger.Info("normalized ratings recomputed",
+		zap.String("method", string(s.params.Method)),
+		zap.Int64("games", games),
+	)
+
+	return nil
+}
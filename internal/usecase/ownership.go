@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type OwnershipRepository interface {
+	ApplyOwnershipEventRepo(ctx context.Context, ev entity.OwnershipEvent, minPlaytimeMinutes int64) (entity.PlayerGame, error)
+	ReverifyRepo(ctx context.Context, minPlaytimeMinutes int64) (int64, error)
+}
+
+type ownershipService struct {
+	repo        OwnershipRepository
+	validator   *Validator
+	minPlaytime int64
+	logger      *zap.Logger
+}
+
+func NewOwnershipService(repository OwnershipRepository, validator *Validator, cfg config.OwnershipConfig,
+	logger *zap.Logger) *ownershipService {
+
+	logger = logger.With(zap.String("layer", "ownershipService"))
+	return &ownershipService{
+		repo:        repository,
+		validator:   validator,
+		minPlaytime: int64(cfg.MinPlaytime / time.Minute),
+		logger:      logger,
+	}
+}
+
+// HandleOwnershipEvent применяет событие владения или наигранного времени; агрегаты
+// проверенных игроков меняются сразу, если у пары уже есть оценка.
+func (s *ownershipService) HandleOwnershipEvent(ctx context.Context, msg entity.OwnershipMessage) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "HandleOwnershipEvent"))
+
+	if err := s.validator.ValidateOwnershipEvent(msg); err != nil {
+		return err
+	}
+
+	ev := entity.OwnershipEvent{
+		UserID:          uuid.MustParse(msg.UserID).String(),
+		GameID:          uuid.MustParse(msg.GameID).String(),
+		Type:            entity.OwnershipEventType(msg.Type),
+		PlaytimeMinutes: msg.PlaytimeMinutes,
+		OccurredAt:      time.Now(),
+	}
+	if msg.OccurredAt != nil {
+		ev.OccurredAt = *msg.OccurredAt
+	}
+
+	pg, err := s.repo.ApplyOwnershipEventRepo(ctx, ev, s.minPlaytime)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+
+	logger.Info("ownership event applied",
+		zap.String("user_id", ev.UserID),
+		zap.String("game_id", ev.GameID),
+		zap.String("type", string(ev.Type)),
+		zap.Bool("verified", pg.Verified),
+	)
+
+	return nil
+}
+
+// Reverify пересчитывает признак проверенного игрока по текущему порогу - нужен после
+// смены ownership.min_playtime.
+func (s *ownershipService) Reverify(ctx context.Context) (int64, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "Reverify"))
+
+	changed, err := s.repo.ReverifyRepo(ctx, s.minPlaytime)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return 0, err
+	}
+
+	logger.Info("players reverified", zap.Int64("changed", changed), zap.Int64("min_playtime_minutes", s.minPlaytime))
+
+	return changed, nil
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+func (v *Validator) ValidateOwnershipEvent(msg entity.OwnershipMessage) error {
+	verr := &entity.ValidationError{}
+
+	validateUUID(verr, "user_id", msg.UserID)
+	validateUUID(verr, "game_id", msg.GameID)
+	t, err := entity.ParseOwnershipEventType(msg.Type)
+	if err != nil {
+		verr.Add("type", entity.ErrInvalidArgument, err.Error())
+	}
+	if msg.PlaytimeMinutes < 0 {
+		verr.Add("playtime_minutes", entity.ErrInvalidArgument, "playtime_minutes must not be negative")
+	}
+	if t == entity.OwnershipPlaytime && msg.PlaytimeMinutes == 0 {
+		verr.Add("playtime_minutes", entity.ErrRequired, "playtime_minutes is required for playtime events")
+	}
+
+	return verr.OrNil()
+}
@@ -3,34 +3,101 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
 
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
 	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
 	"go.uber.org/zap"
 )
 
 type RatingRepository interface {
-	SubmitRatingRepo(ctx context.Context, userID string, gameID string, rating int32) error
+	SubmitRatingRepo(ctx context.Context, rating entity.Rating) error
 	GetGameRatingRepo(ctx context.Context, gameID string) (entity.GameRating, error)
-	GetTopGamesRepo(ctx context.Context, limit, offset int32) ([]entity.GameRating, error)
+	GetTopGamesRepo(ctx context.Context, limit, offset int32, order entity.TopOrder, filter entity.TopFilter) ([]entity.GameRating, error)
+	GetEmbargoRepo(ctx context.Context, gameID string) (entity.GameRelease, bool, error)
+	ParkRatingRepo(ctx context.Context, rating entity.Rating) error
+	IsEmbargoExemptRepo(ctx context.Context, userID string) (bool, error)
 }
 
 type ratingService struct {
-	repo   RatingRepository
-	logger *zap.Logger
+	repo          RatingRepository
+	validator     *Validator
+	embargoPolicy entity.EmbargoPolicy
+	logger        *zap.Logger
 	//producer kafka.Producer
 
 }
 
-func NewRatingService(repository RatingRepository, logger *zap.Logger) *ratingService {
+func NewRatingService(repository RatingRepository, validator *Validator, embargo config.EmbargoConfig,
+	logger *zap.Logger) (*ratingService, error) {
+
+	policy, err := entity.ParseEmbargoPolicy(embargo.DefaultPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("usecase - NewRatingService: %w", err)
+	}
+
 	logger = logger.With(zap.String("layer", "ratingService"))
-	return &ratingService{repo: repository, logger: logger}
+	return &ratingService{
+		repo:          repository,
+		validator:     validator,
+		embargoPolicy: policy,
+		logger:        logger,
+	}, nil
 }
 
-func (s *ratingService) SubmitRating(ctx context.Context, userID string, gameID string, rating int32) error {
-	logger := s.logger.With(zap.String("func", "SubmitRating"))
+// SubmitRating принимает оценку в шкале scale (пустая - шкала по умолчанию)
+// и сохраняет её вместе с нормализованным score. Оценку ещё не вышедшей игры отклоняет
+// с *entity.EmbargoError или откладывает до выхода - если пользователя нет в списке
+// embargo_exemptions.
+func (s *ratingService) SubmitRating(ctx context.Context, userID string, gameID string, rating int32, scale entity.Scale) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "SubmitRating"))
+
+	scale = s.validator.Scale(scale)
+	if err := s.validator.ValidateSubmit(userID, gameID, rating, scale); err != nil {
+		logger.Info("rating rejected", zap.Error(err))
+		return err
+	}
+
+	r := entity.Rating{
+		UserID: userID,
+		GameID: gameID,
+		Value:  rating,
+		Scale:  scale,
+		Score:  scale.Normalize(rating),
+	}
+
+	release, embargoed, err := s.repo.GetEmbargoRepo(ctx, gameID)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+	if embargoed {
+		exempt, err := s.repo.IsEmbargoExemptRepo(ctx, userID)
+		if err != nil {
+			logger.Error("some error", zap.Error(err))
+			return err
+		}
+		embargoed = !exempt
+	}
+	if embargoed {
+		policy := release.Policy
+		if policy == "" {
+			policy = s.embargoPolicy
+		}
+		if policy == entity.EmbargoReject {
+			logger.Info("rating rejected by embargo", zap.String("game_id", gameID), zap.Time("release_at", release.ReleaseAt))
+			return &entity.EmbargoError{GameID: gameID, ReleaseAt: release.ReleaseAt}
+		}
+		if err := s.repo.ParkRatingRepo(ctx, r); err != nil {
+			logger.Error("some error", zap.Error(err))
+			return err
+		}
+		return nil
+	}
 
-	if err := s.repo.SubmitRatingRepo(ctx, userID, gameID, rating); err != nil {
+	if err := s.repo.SubmitRatingRepo(ctx, r); err != nil {
 		logger.Error("some error", zap.Error(err))
 		return err
 	}
@@ -40,8 +107,14 @@ func (s *ratingService) SubmitRating(ctx context.Context, userID string, gameID
 	return nil
 }
 
-func (s *ratingService) GetGameRating(ctx context.Context, gameID string) (entity.GameRating, error) {
-	logger := s.logger.With(zap.String("func", "GetGameRating"))
+// GetGameRating возвращает среднее, спроецированное на шкалу scale (пустая - каноническая 1-10).
+func (s *ratingService) GetGameRating(ctx context.Context, gameID string, scale entity.Scale) (entity.GameRating, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "GetGameRating"))
+
+	scale = readScale(scale)
+	if err := s.validator.ValidateGameID(gameID, scale); err != nil {
+		return entity.GameRating{}, err
+	}
 
 	game, err := s.repo.GetGameRatingRepo(ctx, gameID)
 
@@ -58,20 +131,61 @@ func (s *ratingService) GetGameRating(ctx context.Context, gameID string) (entit
 
 	logger.Info("game successfuly found")
 
-	return game, nil
+	return projectRating(game, scale), nil
 }
 
-func (s *ratingService) GetTopGames(ctx context.Context, limit, offset int32) ([]entity.GameRating, error) {
-	logger := s.logger.With(zap.String("func", "GetTopGames"))
+// GetTopGames - страница топа по обычному, нормализованному, взвешенному среднему или
+// среднему проверенных игроков (order), при непустом filter - только среди игр каталога
+// с подходящими жанром, тегами, годом выхода и издателем.
+func (s *ratingService) GetTopGames(ctx context.Context, limit, offset int32, scale entity.Scale, order entity.TopOrder,
+	filter entity.TopFilter) ([]entity.GameRating, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "GetTopGames"))
+
+	scale = readScale(scale)
+	if err := s.validator.ValidateScale(scale); err != nil {
+		return []entity.GameRating{}, err
+	}
+	if err := s.validator.ValidateTopFilter(filter); err != nil {
+		return []entity.GameRating{}, err
+	}
 
-	list, err := s.repo.GetTopGamesRepo(ctx, limit, offset)
+	list, err := s.repo.GetTopGamesRepo(ctx, limit, offset, order, normalizeTopFilter(filter))
 
 	if err != nil {
 		logger.Error("some error", zap.Error(err))
 		return []entity.GameRating{}, err
 	}
 
+	for i := range list {
+		list[i] = projectRating(list[i], scale)
+	}
+
 	logger.Info("games successfuly found")
 
 	return list, nil
 }
+
+func readScale(scale entity.Scale) entity.Scale {
+	if scale == "" {
+		return entity.ScaleTen
+	}
+	return scale
+}
+
+func projectRating(game entity.GameRating, scale entity.Scale) entity.GameRating {
+	game.AverageRating = scale.Project(game.AverageRating)
+	if game.NormalizedRating != nil {
+		normalized := scale.Project(*game.NormalizedRating)
+		game.NormalizedRating = &normalized
+	}
+	if game.WeightedRating != nil {
+		weighted := scale.Project(*game.WeightedRating)
+		game.WeightedRating = &weighted
+	}
+	if game.VerifiedRating != nil {
+		verified := scale.Project(*game.VerifiedRating)
+		game.VerifiedRating = &verified
+	}
+	game.Scale = scale
+	return game
+}
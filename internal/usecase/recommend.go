@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/recommend"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// _defaultRecommendLimit - сколько игр отдавать, если limit не задан.
+const _defaultRecommendLimit = 10
+
+type RecommendRepository interface {
+	RecommendCFRepo(ctx context.Context, userID string, limit int, excludeRated bool, minNeighbors int) ([]entity.Recommendation, error)
+	PopularGamesRepo(ctx context.Context, limit int, prior float64, skip []string, excludeUser string) ([]entity.Recommendation, error)
+	ExportRepo(ctx context.Context, filter entity.ExportFilter,
+		onRating func(entity.ExportRating) error, onGame func(entity.ExportGameRating) error) error
+}
+
+type recommendService struct {
+	repo      RecommendRepository
+	validator *Validator
+	sim       entity.SimilarityParams
+	params    entity.RecommendParams
+	maxLimit  int
+	logger    *zap.Logger
+}
+
+func NewRecommendService(repository RecommendRepository, validator *Validator, simCfg config.SimilarityConfig,
+	cfg config.RecommendConfig, logger *zap.Logger) (*recommendService, error) {
+
+	method, err := entity.ParseSimilarityMethod(simCfg.Method)
+	if err != nil {
+		return nil, fmt.Errorf("usecase - NewRecommendService: %w", err)
+	}
+
+	logger = logger.With(zap.String("layer", "recommendService"))
+	return &recommendService{
+		repo:      repository,
+		validator: validator,
+		sim:       entity.SimilarityParams{Method: method, MinCoRaters: simCfg.MinCoRaters, TopK: simCfg.TopK},
+		params:    entity.RecommendParams{MinNeighbors: cfg.MinNeighbors, PopularityPrior: cfg.PopularityPrior},
+		maxLimit:  cfg.MaxLimit,
+		logger:    logger,
+	}, nil
+}
+
+// RecommendGames - игры, которые пользователю должны понравиться, по убыванию предсказанной
+// оценки. Сначала item-based CF по соседям оценённых им игр; если так набралось меньше limit
+// (новый пользователь, редкие игры), остаток добирается популярными играми.
+func (s *recommendService) RecommendGames(ctx context.Context, userID string, limit int, excludeRated bool) ([]entity.Recommendation, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "RecommendGames"))
+
+	if limit == 0 {
+		limit = min(_defaultRecommendLimit, s.maxLimit)
+	}
+	if err := s.validator.ValidateRecommend(userID, limit, s.maxLimit); err != nil {
+		return nil, err
+	}
+	userID = uuid.MustParse(userID).String()
+
+	recs, err := s.repo.RecommendCFRepo(ctx, userID, limit, excludeRated, s.params.MinNeighbors)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return nil, err
+	}
+	for i := range recs {
+		recs[i].PredictedScore = entity.ClampScore(recs[i].PredictedScore)
+	}
+
+	if len(recs) < limit {
+		skip := make([]string, 0, len(recs))
+		for _, r := range recs {
+			skip = append(skip, r.GameID)
+		}
+		excludeUser := ""
+		if excludeRated {
+			excludeUser = userID
+		}
+
+		popular, err := s.repo.PopularGamesRepo(ctx, limit-len(recs), s.params.PopularityPrior, skip, excludeUser)
+		if err != nil {
+			logger.Error("some error", zap.Error(err))
+			return nil, err
+		}
+		recs = append(recs, popular...)
+	}
+
+	return recs, nil
+}
+
+// Evaluate - офлайн-оценка рекомендаций на текущих оценках из базы (см. recommend.Evaluate).
+// Все оценки читаются в память.
+func (s *recommendService) Evaluate(ctx context.Context, opts entity.EvalOptions) (entity.EvalResult, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "Evaluate"))
+
+	if err := s.validator.ValidateEval(opts); err != nil {
+		return entity.EvalResult{}, err
+	}
+
+	var ratings []recommend.Rating
+	err := s.repo.ExportRepo(ctx, entity.ExportFilter{Datasets: []entity.Dataset{entity.DatasetRatings}},
+		func(r entity.ExportRating) error {
+			ratings = append(ratings, recommend.Rating{UserID: r.UserID, GameID: r.GameID, Score: r.Score})
+			return nil
+		}, nil)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return entity.EvalResult{}, err
+	}
+
+	res := recommend.Evaluate(ratings, s.sim, s.params, opts)
+
+	logger.Info("evaluation finished",
+		zap.Int("train", res.TrainRatings),
+		zap.Int("test", res.TestRatings),
+		zap.Float64("rmse", res.RMSE),
+		zap.Float64("precision_at_k", res.PrecisionAtK),
+	)
+
+	return res, nil
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+func (v *Validator) ValidateRecommend(userID string, limit, maxLimit int) error {
+	verr := &entity.ValidationError{}
+
+	validateUUID(verr, "user_id", userID)
+	if limit < 1 || limit > maxLimit {
+		verr.Add("limit", entity.ErrInvalidArgument, fmt.Sprintf("limit must be between 1 and %d", maxLimit))
+	}
+
+	return verr.OrNil()
+}
+
+func (v *Validator) ValidateEval(opts entity.EvalOptions) error {
+	verr := &entity.ValidationError{}
+
+	if opts.TestFraction <= 0 || opts.TestFraction >= 1 {
+		verr.Add("test_fraction", entity.ErrInvalidArgument, "test fraction must be between 0 and 1")
+	}
+	if opts.K < 1 {
+		verr.Add("k", entity.ErrInvalidArgument, "k must be positive")
+	}
+	if opts.RelevantScore < entity.ScoreMin || opts.RelevantScore > entity.ScoreMax {
+		verr.Add("relevant_score", entity.ErrInvalidArgument,
+			fmt.Sprintf("relevant score must be between %g and %g", entity.ScoreMin, entity.ScoreMax))
+	}
+
+	return verr.OrNil()
+}
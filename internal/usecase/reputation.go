@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ReputationRepository interface {
+	ApplyUserEventRepo(ctx context.Context, ev entity.UserEvent) error
+	RecalculateWeightsRepo(ctx context.Context, params entity.ReputationParams) (entity.ReweightStats, error)
+}
+
+type reputationService struct {
+	repo      ReputationRepository
+	validator *Validator
+	params    entity.ReputationParams
+	logger    *zap.Logger
+}
+
+func NewReputationService(repository ReputationRepository, validator *Validator, cfg config.ReputationConfig,
+	logger *zap.Logger) *reputationService {
+
+	logger = logger.With(zap.String("layer", "reputationService"))
+	return &reputationService{
+		repo:      repository,
+		validator: validator,
+		params: entity.ReputationParams{
+			AgeFull:             cfg.AgeFull,
+			HistoryFull:         cfg.HistoryFull,
+			MinConsensusRatings: cfg.MinConsensusRatings,
+			AgreementScale:      cfg.AgreementScale,
+			MinWeight:           cfg.MinWeight,
+			FlaggedFactor:       cfg.FlaggedFactor,
+			PastFlagFactor:      cfg.PastFlagFactor,
+			ChangeThreshold:     cfg.ChangeThreshold,
+		},
+		logger: logger,
+	}
+}
+
+// HandleUserEvent сохраняет событие аккаунта. Вес пользователя изменится при следующем
+// Recalculate, а не сразу: пересчёт затрагивает все игры, которые он оценивал.
+func (s *reputationService) HandleUserEvent(ctx context.Context, msg entity.UserEventMessage) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "HandleUserEvent"))
+
+	if err := s.validator.ValidateUserEvent(msg); err != nil {
+		return err
+	}
+
+	ev := entity.UserEvent{
+		UserID:     uuid.MustParse(msg.UserID).String(),
+		Type:       entity.UserEventType(msg.Type),
+		OccurredAt: time.Now(),
+		Reason:     msg.Reason,
+	}
+	if msg.OccurredAt != nil {
+		ev.OccurredAt = *msg.OccurredAt
+	}
+
+	if err := s.repo.ApplyUserEventRepo(ctx, ev); err != nil {
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+
+	logger.Info("user event applied", zap.String("user_id", ev.UserID), zap.String("type", string(ev.Type)))
+
+	return nil
+}
+
+// Recalculate пересчитывает веса оценщиков и взвешенный рейтинг затронутых игр.
+func (s *reputationService) Recalculate(ctx context.Context) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "Recalculate"))
+
+	stats, err := s.repo.RecalculateWeightsRepo(ctx, s.params)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return err
+	}
+
+	logger.Info("rater weights recalculated",
+		zap.Int64("changed_users", stats.Users),
+		zap.Int64("games", stats.Games),
+	)
+
+	return nil
+}
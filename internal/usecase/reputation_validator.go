@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+func (v *Validator) ValidateUserEvent(msg entity.UserEventMessage) error {
+	verr := &entity.ValidationError{}
+
+	validateUUID(verr, "user_id", msg.UserID)
+	if _, err := entity.ParseUserEventType(msg.Type); err != nil {
+		verr.Add("type", entity.ErrInvalidArgument, err.Error())
+	}
+
+	return verr.OrNil()
+}
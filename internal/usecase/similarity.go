@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// _defaultSimilarK - сколько соседей отдавать, если k не задан.
+const _defaultSimilarK = 10
+
+type SimilarityRepository interface {
+	RefreshSimilaritiesRepo(ctx context.Context, params entity.SimilarityParams, batch int) (int, error)
+	RebuildSimilaritiesRepo(ctx context.Context, params entity.SimilarityParams) (int64, error)
+	GetSimilarGamesRepo(ctx context.Context, gameID string, k int) ([]entity.SimilarGame, error)
+}
+
+type similarityService struct {
+	repo      SimilarityRepository
+	validator *Validator
+	params    entity.SimilarityParams
+	batch     int
+	logger    *zap.Logger
+}
+
+func NewSimilarityService(repository SimilarityRepository, validator *Validator, cfg config.SimilarityConfig,
+	logger *zap.Logger) (*similarityService, error) {
+
+	method, err := entity.ParseSimilarityMethod(cfg.Method)
+	if err != nil {
+		return nil, fmt.Errorf("usecase - NewSimilarityService: %w", err)
+	}
+
+	logger = logger.With(zap.String("layer", "similarityService"))
+	return &similarityService{
+		repo:      repository,
+		validator: validator,
+		params:    entity.SimilarityParams{Method: method, MinCoRaters: cfg.MinCoRaters, TopK: cfg.TopK},
+		batch:     cfg.RefreshBatch,
+		logger:    logger,
+	}, nil
+}
+
+// Refresh пересчитывает соседей всех игр, у которых менялись оценки, пачками по batch.
+// Вызывается периодически.
+func (s *similarityService) Refresh(ctx context.Context) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "Refresh"))
+
+	total := 0
+	for ctx.Err() == nil {
+		n, err := s.repo.RefreshSimilaritiesRepo(ctx, s.params, s.batch)
+		if err != nil {
+			logger.Error("some error", zap.Error(err))
+			return err
+		}
+		total += n
+		if n < s.batch {
+			break
+		}
+	}
+
+	if total > 0 {
+		logger.Info("similarities refreshed", zap.Int("games", total))
+	}
+
+	return ctx.Err()
+}
+
+// Rebuild пересчитывает соседей всех игр с нуля.
+func (s *similarityService) Rebuild(ctx context.Context) (int64, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "Rebuild"))
+
+	n, err := s.repo.RebuildSimilaritiesRepo(ctx, s.params)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// GetSimilarGames - до k самых похожих игр; пустой список, если у игры не набралось
+// соседей с достаточным числом общих оценщиков. k == 0 - значение по умолчанию.
+func (s *similarityService) GetSimilarGames(ctx context.Context, gameID string, k int) ([]entity.SimilarGame, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "GetSimilarGames"))
+
+	if k == 0 {
+		k = min(_defaultSimilarK, s.params.TopK)
+	}
+	if err := s.validator.ValidateSimilarGames(gameID, k, s.params.TopK); err != nil {
+		return nil, err
+	}
+
+	games, err := s.repo.GetSimilarGamesRepo(ctx, uuid.MustParse(gameID).String(), k)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return nil, err
+	}
+
+	return games, nil
+}
@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// ValidateSimilarGames - k от 1 до maxK: глубже maxK соседей не хранится.
+func (v *Validator) ValidateSimilarGames(gameID string, k, maxK int) error {
+	verr := &entity.ValidationError{}
+
+	validateUUID(verr, "game_id", gameID)
+	if k < 1 || k > maxK {
+		verr.Add("k", entity.ErrInvalidArgument, fmt.Sprintf("k must be between 1 and %d", maxK))
+	}
+
+	return verr.OrNil()
+}
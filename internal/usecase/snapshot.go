@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type SnapshotRepository interface {
+	TakeSnapshotsRepo(ctx context.Context, g entity.Granularity, at time.Time) (int64, error)
+	BackfillSnapshotsRepo(ctx context.Context, g entity.Granularity, replace bool) (int64, error)
+	PruneSnapshotsRepo(ctx context.Context, g entity.Granularity, before time.Time) (int64, error)
+	GetTimelineRepo(ctx context.Context, gameID string, g entity.Granularity, buckets []time.Time) ([]entity.TimelinePoint, error)
+}
+
+type snapshotService struct {
+	repo      SnapshotRepository
+	validator *Validator
+	cfg       config.SnapshotConfig
+	logger    *zap.Logger
+}
+
+func NewSnapshotService(repository SnapshotRepository, validator *Validator, cfg config.SnapshotConfig, logger *zap.Logger) *snapshotService {
+	logger = logger.With(zap.String("layer", "snapshotService"))
+	return &snapshotService{repo: repository, validator: validator, cfg: cfg, logger: logger}
+}
+
+// TakeSnapshots снимает дневные (и, если включены, почасовые) агрегаты за текущий интервал
+// и чистит почасовые снимки старше HourlyRetention. Вызывается периодически.
+func (s *snapshotService) TakeSnapshots(ctx context.Context) error {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "TakeSnapshots"))
+
+	now := time.Now()
+	granularities := []entity.Granularity{entity.GranularityDay}
+	if s.cfg.Hourly {
+		granularities = append(granularities, entity.GranularityHour)
+	}
+
+	for _, g := range granularities {
+		n, err := s.repo.TakeSnapshotsRepo(ctx, g, now)
+		if err != nil {
+			logger.Error("some error", zap.String("granularity", string(g)), zap.Error(err))
+			return err
+		}
+		logger.Debug("snapshots taken", zap.String("granularity", string(g)), zap.Int64("games", n))
+	}
+
+	if s.cfg.Hourly {
+		n, err := s.repo.PruneSnapshotsRepo(ctx, entity.GranularityHour, now.Add(-s.cfg.HourlyRetention))
+		if err != nil {
+			logger.Error("some error", zap.Error(err))
+			return err
+		}
+		if n > 0 {
+			logger.Info("hourly snapshots pruned", zap.Int64("rows", n))
+		}
+	}
+
+	return nil
+}
+
+// Backfill восстанавливает снимки по created_at оценок - для истории до включения снимков.
+func (s *snapshotService) Backfill(ctx context.Context, g entity.Granularity, replace bool) (int64, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "Backfill"))
+
+	n, err := s.repo.BackfillSnapshotsRepo(ctx, g, replace)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// GetRatingTimeline возвращает рейтинг игры по интервалам, начинающимся в [from, to).
+// Пустой to - до текущего момента. Для интервала без своего снимка берётся последний
+// предыдущий; интервалы до первого снимка игры в ответ не попадают.
+func (s *snapshotService) GetRatingTimeline(ctx context.Context, gameID string, from, to time.Time,
+	g entity.Granularity, scale entity.Scale) (entity.Timeline, error) {
+
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "GetRatingTimeline"))
+
+	scale = readScale(scale)
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if err := s.validator.ValidateTimeline(gameID, from, to, g, scale, s.cfg.MaxPoints); err != nil {
+		return entity.Timeline{}, err
+	}
+	gameID = uuid.MustParse(gameID).String()
+
+	var buckets []time.Time
+	for b := g.Truncate(from); b.Before(to); b = b.Add(g.Step()) {
+		buckets = append(buckets, b)
+	}
+
+	points, err := s.repo.GetTimelineRepo(ctx, gameID, g, buckets)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return entity.Timeline{}, err
+	}
+
+	for i := range points {
+		if points[i].RatingsCount > 0 {
+			points[i].AverageRating = scale.Project(points[i].AverageRating)
+		}
+	}
+
+	return entity.Timeline{GameID: gameID, Granularity: g, Scale: scale, Points: points}, nil
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// ValidateTimeline - окно [from, to) должно быть непустым и давать не больше maxPoints точек.
+func (v *Validator) ValidateTimeline(gameID string, from, to time.Time, g entity.Granularity, scale entity.Scale, maxPoints int) error {
+	verr := &entity.ValidationError{}
+
+	validateUUID(verr, "game_id", gameID)
+	validateKnownScale(verr, scale)
+	switch {
+	case from.IsZero():
+		verr.Add("from", entity.ErrRequired, "from is required")
+	case !from.Before(to):
+		verr.Add("to", entity.ErrInvalidArgument, "to must be after from")
+	case to.Sub(g.Truncate(from)) > time.Duration(maxPoints)*g.Step():
+		verr.Add("to", entity.ErrInvalidArgument,
+			fmt.Sprintf("at most %d %s points per request", maxPoints, g))
+	}
+
+	return verr.OrNil()
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"go.uber.org/zap"
+)
+
+type StudioRepository interface {
+	GetTopStudiosRepo(ctx context.Context, role entity.StudioRole, minRatings int64, limit, offset int32) ([]entity.StudioRating, error)
+	GetStudioRepo(ctx context.Context, role entity.StudioRole, name string) (entity.StudioRating, error)
+	ListStudioGamesRepo(ctx context.Context, role entity.StudioRole, name string, limit, offset int32) ([]entity.GameRating, error)
+}
+
+type studioService struct {
+	repo        StudioRepository
+	validator   *Validator
+	maxPageSize int
+	logger      *zap.Logger
+}
+
+func NewStudioService(repository StudioRepository, validator *Validator, cfg config.StudioConfig,
+	logger *zap.Logger) *studioService {
+
+	logger = logger.With(zap.String("layer", "studioService"))
+	return &studioService{repo: repository, validator: validator, maxPageSize: cfg.MaxPageSize, logger: logger}
+}
+
+// GetTopStudios - страница топа разработчиков или издателей; minRatings отсекает студии
+// с малым числом оценок, у которых среднее случайно.
+func (s *studioService) GetTopStudios(ctx context.Context, role entity.StudioRole, minRatings int64, limit, offset int32,
+	scale entity.Scale) ([]entity.StudioRating, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "GetTopStudios"))
+
+	scale = readScale(scale)
+	if err := s.validator.ValidateStudioQuery(role, "", false, limit, offset, s.maxPageSize, scale); err != nil {
+		return nil, err
+	}
+
+	studios, err := s.repo.GetTopStudiosRepo(ctx, role, max(minRatings, 0), limit, offset)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return nil, err
+	}
+
+	for i := range studios {
+		studios[i] = projectStudio(studios[i], scale)
+	}
+
+	return studios, nil
+}
+
+// ListStudioGames - агрегат студии и страница её оценённых игр по убыванию среднего.
+func (s *studioService) ListStudioGames(ctx context.Context, role entity.StudioRole, name string, limit, offset int32,
+	scale entity.Scale) (entity.StudioRating, []entity.GameRating, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "ListStudioGames"))
+
+	scale = readScale(scale)
+	if err := s.validator.ValidateStudioQuery(role, name, true, limit, offset, s.maxPageSize, scale); err != nil {
+		return entity.StudioRating{}, nil, err
+	}
+	name = strings.TrimSpace(name)
+
+	studio, err := s.repo.GetStudioRepo(ctx, role, name)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return entity.StudioRating{}, nil, err
+	}
+
+	games, err := s.repo.ListStudioGamesRepo(ctx, role, name, limit, offset)
+	if err != nil {
+		logger.Error("some error", zap.Error(err))
+		return entity.StudioRating{}, nil, err
+	}
+
+	for i := range games {
+		games[i] = projectRating(games[i], scale)
+	}
+
+	return projectStudio(studio, scale), games, nil
+}
+
+func projectStudio(studio entity.StudioRating, scale entity.Scale) entity.StudioRating {
+	studio.AverageRating = scale.Project(studio.AverageRating)
+	studio.Scale = scale
+	return studio
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// ValidateStudioQuery проверяет запрос к агрегатам студий; name не проверяется, если withName == false.
+func (v *Validator) ValidateStudioQuery(role entity.StudioRole, name string, withName bool, limit, offset int32,
+	maxPageSize int, scale entity.Scale) error {
+	verr := &entity.ValidationError{}
+
+	if _, err := entity.ParseStudioRole(string(role)); err != nil {
+		verr.Add("role", entity.ErrInvalidArgument, err.Error())
+	}
+	if withName && strings.TrimSpace(name) == "" {
+		verr.Add("name", entity.ErrRequired, "name is required")
+	}
+	if limit < 1 || int(limit) > maxPageSize {
+		verr.Add("limit", entity.ErrInvalidArgument, fmt.Sprintf("limit must be between 1 and %d", maxPageSize))
+	}
+	if offset < 0 {
+		verr.Add("offset", entity.ErrInvalidArgument, "offset must not be negative")
+	}
+	validateKnownScale(verr, scale)
+
+	return verr.OrNil()
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/google/uuid"
+)
+
+// Validator - общие для всех транспортов (gRPC, Kafka, импорт) проверки входных данных.
+// Проверки отдельных сценариев лежат рядом с ними, в <сценарий>_validator.go.
+type Validator struct {
+	defaultScale  entity.Scale
+	allowedScales map[entity.Scale]struct{}
+	knownGames    map[string]struct{}
+}
+
+func NewValidator(cfg config.ValidationConfig) (*Validator, error) {
+	def, err := entity.ParseScale(cfg.DefaultScale, entity.ScaleTen)
+	if err != nil {
+		return nil, fmt.Errorf("usecase - NewValidator - default_scale: %w", err)
+	}
+
+	v := &Validator{defaultScale: def}
+
+	if len(cfg.AllowedScales) > 0 {
+		v.allowedScales = make(map[entity.Scale]struct{}, len(cfg.AllowedScales))
+		for _, name := range cfg.AllowedScales {
+			sc, err := entity.ParseScale(name, def)
+			if err != nil {
+				return nil, fmt.Errorf("usecase - NewValidator - allowed_scales: %w", err)
+			}
+			v.allowedScales[sc] = struct{}{}
+		}
+		if _, ok := v.allowedScales[def]; !ok {
+			return nil, fmt.Errorf("usecase - NewValidator - default_scale %q is not allowed", def)
+		}
+	}
+
+	games := append([]string(nil), cfg.KnownGames...)
+	if cfg.KnownGamesFile != "" {
+		fromFile, err := readGameList(cfg.KnownGamesFile)
+		if err != nil {
+			return nil, fmt.Errorf("usecase - NewValidator - readGameList: %w", err)
+		}
+		games = append(games, fromFile...)
+	}
+
+	if len(games) > 0 {
+		v.knownGames = make(map[string]struct{}, len(games))
+		for _, g := range games {
+			id, err := uuid.Parse(g)
+			if err != nil {
+				return nil, fmt.Errorf("usecase - NewValidator - invalid known game %q: %w", g, err)
+			}
+			v.knownGames[id.String()] = struct{}{}
+		}
+	}
+
+	return v, nil
+}
+
+// Scale подставляет шкалу по умолчанию вместо пустой.
+func (v *Validator) Scale(scale entity.Scale) entity.Scale {
+	if scale == "" {
+		return v.defaultScale
+	}
+	return scale
+}
+
+func (v *Validator) ValidateSubmit(userID, gameID string, rating int32, scale entity.Scale) error {
+	verr := &entity.ValidationError{}
+	v.validateSubmit(verr, userID, gameID, rating, scale)
+	return verr.OrNil()
+}
+
+func (v *Validator) validateSubmit(verr *entity.ValidationError, userID, gameID string, rating int32, scale entity.Scale) {
+	validateUUID(verr, "user_id", userID)
+	if validateUUID(verr, "game_id", gameID) {
+		v.validateKnownGame(verr, gameID)
+	}
+
+	if v.validateScale(verr, scale) && !scale.Contains(rating) {
+		min, max := scale.Range()
+		verr.Add("rating", entity.ErrInvalidScore,
+			fmt.Sprintf("rating must be between %d and %d on scale %s", min, max, scale))
+	}
+}
+
+// ValidateGameID проверяет запрос на чтение: проецировать среднее можно на любую известную шкалу.
+func (v *Validator) ValidateGameID(gameID string, scale entity.Scale) error {
+	verr := &entity.ValidationError{}
+	validateUUID(verr, "game_id", gameID)
+	validateKnownScale(verr, scale)
+	return verr.OrNil()
+}
+
+func (v *Validator) ValidateUserID(userID string) error {
+	verr := &entity.ValidationError{}
+	validateUUID(verr, "user_id", userID)
+	return verr.OrNil()
+}
+
+func (v *Validator) ValidateScale(scale entity.Scale) error {
+	verr := &entity.ValidationError{}
+	validateKnownScale(verr, scale)
+	return verr.OrNil()
+}
+
+func (v *Validator) validateScale(verr *entity.ValidationError, scale entity.Scale) bool {
+	if !validateKnownScale(verr, scale) {
+		return false
+	}
+	if v.allowedScales == nil {
+		return true
+	}
+	if _, ok := v.allowedScales[scale]; !ok {
+		verr.Add("scale", entity.ErrInvalidArgument, fmt.Sprintf("rating scale %q is not allowed", scale))
+		return false
+	}
+	return true
+}
+
+func (v *Validator) validateKnownGame(verr *entity.ValidationError, gameID string) {
+	if v.knownGames == nil {
+		return
+	}
+	if _, ok := v.knownGames[uuid.MustParse(gameID).String()]; !ok {
+		verr.Add("game_id", entity.ErrUnknownGame, "game_id is not a known game")
+	}
+}
+
+func validateKnownScale(verr *entity.ValidationError, scale entity.Scale) bool {
+	if !scale.Valid() {
+		verr.Add("scale", entity.ErrInvalidArgument, fmt.Sprintf("unknown rating scale %q", scale))
+		return false
+	}
+	return true
+}
+
+func validateUUID(verr *entity.ValidationError, field, value string) bool {
+	if value == "" {
+		verr.Add(field, entity.ErrRequired, field+" is required")
+		return false
+	}
+	if err := uuid.Validate(value); err != nil {
+		verr.Add(field, entity.ErrInvalidUUID, field+" must be a valid UUID")
+		return false
+	}
+	return true
+}
+
+// readGameList читает файл с game_id - по одному на строку, # - комментарий.
+func readGameList(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var out []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		out = append(out, line)
+	}
+	return out, sc.Err()
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/watch"
+	"github.com/RozmiDan/gameReviewHubRating/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type WatchHub interface {
+	Subscribe(gameIDs []string) (*watch.Subscription, error)
+}
+
+type watchService struct {
+	hub       WatchHub
+	repo      RatingRepository
+	validator *Validator
+	maxGames  int
+	logger    *zap.Logger
+}
+
+func NewWatchService(hub WatchHub, repository RatingRepository, validator *Validator, maxGames int, logger *zap.Logger) *watchService {
+	logger = logger.With(zap.String("layer", "watchService"))
+	return &watchService{hub: hub, repo: repository, validator: validator, maxGames: maxGames, logger: logger}
+}
+
+// Watch подписывает на изменения игр gameIDs. Первая пачка стрима - текущие рейтинги этих игр;
+// пустой gameIDs - изменения всех игр без начального снимка.
+func (s *watchService) Watch(ctx context.Context, gameIDs []string, scale entity.Scale) (entity.RatingStream, error) {
+	logger := tracing.Logger(ctx, s.logger).With(zap.String("func", "Watch"))
+
+	scale = readScale(scale)
+	if err := s.validator.ValidateWatch(gameIDs, scale, s.maxGames); err != nil {
+		return nil, err
+	}
+	gameIDs = canonicalIDs(gameIDs)
+
+	// подписываемся до чтения снимка, чтобы не потерять изменения между ними
+	sub, err := s.hub.Subscribe(gameIDs)
+	if err != nil {
+		logger.Warn("subscribe failed", zap.Error(err))
+		return nil, err
+	}
+
+	var initial []entity.GameRatingUpdate
+	for _, g := range gameIDs {
+		game, err := s.repo.GetGameRatingRepo(ctx, g)
+		if errors.Is(err, entity.ErrGameNotFound) {
+			continue
+		}
+		if err != nil {
+			sub.Close()
+			logger.Error("some error", zap.Error(err))
+			return nil, err
+		}
+		initial = append(initial, entity.GameRatingUpdate{GameRating: projectRating(game, scale)})
+	}
+
+	logger.Info("watch started", zap.Int("games", len(gameIDs)))
+
+	return &ratingStream{sub: sub, scale: scale, initial: initial}, nil
+}
+
+type ratingStream struct {
+	sub     *watch.Subscription
+	scale   entity.Scale
+	initial []entity.GameRatingUpdate
+}
+
+func (s *ratingStream) Next(ctx context.Context) ([]entity.GameRatingUpdate, error) {
+	if s.initial != nil {
+		out := s.initial
+		s.initial = nil
+		return out, nil
+	}
+
+	updates, err := s.sub.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, u := range updates {
+		// у игры без оценок среднего нет - проецировать нечего
+		if u.RatingsCount > 0 {
+			updates[i].GameRating = projectRating(u.GameRating, s.scale)
+		} else {
+			updates[i].Scale = s.scale
+		}
+	}
+	return updates, nil
+}
+
+func (s *ratingStream) Close() {
+	s.sub.Close()
+}
+
+// canonicalIDs приводит UUID к виду, в котором их отдаёт Postgres, и убирает повторы.
+func canonicalIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		c := uuid.MustParse(id).String()
+		if _, ok := seen[c]; ok {
+			continue
+		}
+		seen[c] = struct{}{}
+		out = append(out, c)
+	}
+	return out
+}
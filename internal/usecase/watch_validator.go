@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+// ValidateWatch - подписка на обновления: не больше maxGames игр, пустой список - все игры.
+func (v *Validator) ValidateWatch(gameIDs []string, scale entity.Scale, maxGames int) error {
+	verr := &entity.ValidationError{}
+
+	if len(gameIDs) > maxGames {
+		verr.Add("game_ids", entity.ErrInvalidArgument, fmt.Sprintf("at most %d games per subscription", maxGames))
+	}
+	for _, id := range gameIDs {
+		if !validateUUID(verr, "game_ids", id) {
+			break
+		}
+	}
+	validateKnownScale(verr, scale)
+
+	return verr.OrNil()
+}
@@ -0,0 +1,217 @@
+// Package watch раздаёт изменения game_ratings подписчикам (gRPC-стримы, SSE).
+//
+// Источник - LISTEN/NOTIFY в Postgres. Обновления одной игры у подписчика схлопываются:
+// пока он не забрал предыдущее, новое просто его заменяет, поэтому Hub никогда не ждёт
+// медленного клиента. Если у подписчика копится больше MaxPending разных игр, он отключается
+// с entity.ErrSlowConsumer.
+package watch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/config"
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+	"github.com/RozmiDan/gameReviewHubRating/internal/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	_minBackoff = 500 * time.Millisecond
+	_maxBackoff = 30 * time.Second
+)
+
+// Source - откуда берутся обновления; реализует postgres_storage.RatingRepository.
+type Source interface {
+	// ListenGameRatingsRepo блокируется, пока соединение живо; onListen - LISTEN выполнен.
+	ListenGameRatingsRepo(ctx context.Context, onListen func(), fn func(entity.GameRatingUpdate)) error
+	GetGameRatingRepo(ctx context.Context, gameID string) (entity.GameRating, error)
+}
+
+var errHubStopped = &entity.RetryableError{Err: entity.ErrUnavailable, Reason: "server is shutting down"}
+
+type Hub struct {
+	src     Source
+	cfg     config.WatchConfig
+	metrics *metrics.Metrics
+	logger  *zap.Logger
+
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+	// anyGame - подписки на все игры, byGame - на конкретные
+	anyGame map[*Subscription]struct{}
+	byGame  map[string]map[*Subscription]struct{}
+	closed  bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func New(src Source, cfg config.WatchConfig, m *metrics.Metrics, logger *zap.Logger) *Hub {
+	return &Hub{
+		src:     src,
+		cfg:     cfg,
+		metrics: m,
+		logger:  logger.With(zap.String("component", "watch")),
+		subs:    make(map[*Subscription]struct{}),
+		anyGame: make(map[*Subscription]struct{}),
+		byGame:  make(map[string]map[*Subscription]struct{}),
+	}
+}
+
+func (h *Hub) Name() string { return "watch" }
+
+func (h *Hub) Start(ctx context.Context) error {
+	ctx, h.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	h.done = make(chan struct{})
+	go h.run(ctx)
+	return nil
+}
+
+// Stop отключает всех подписчиков и закрывает LISTEN-соединение.
+func (h *Hub) Stop(ctx context.Context) error {
+	h.mu.Lock()
+	h.closed = true
+	for sub := range h.subs {
+		sub.fail(errHubStopped)
+	}
+	h.mu.Unlock()
+
+	if h.cancel == nil {
+		return nil
+	}
+	h.cancel()
+
+	select {
+	case <-h.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run держит LISTEN и переподключается с экспоненциальной задержкой. После
+// переподключения перечитывает игры, на которые есть подписки, - уведомления
+// за время разрыва потеряны. Подписки на все игры такой досылки не получают.
+func (h *Hub) run(ctx context.Context) {
+	defer close(h.done)
+
+	backoff := _minBackoff
+	for attempt := 0; ; attempt++ {
+		onListen := func() {
+			backoff = _minBackoff
+			if attempt > 0 {
+				go h.resync(ctx)
+			}
+		}
+
+		err := h.src.ListenGameRatingsRepo(ctx, onListen, h.publish)
+		if ctx.Err() != nil {
+			return
+		}
+		h.metrics.WatchReconnects.Inc()
+		h.logger.Warn("listener stopped, reconnecting", zap.Error(err), zap.Duration("backoff", backoff))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, _maxBackoff)
+	}
+}
+
+func (h *Hub) resync(ctx context.Context) {
+	h.mu.Lock()
+	games := make([]string, 0, len(h.byGame))
+	for g := range h.byGame {
+		games = append(games, g)
+	}
+	h.mu.Unlock()
+
+	for _, g := range games {
+		game, err := h.src.GetGameRatingRepo(ctx, g)
+		if errors.Is(err, entity.ErrGameNotFound) {
+			game, err = entity.GameRating{GameId: g}, nil
+		}
+		if err != nil {
+			h.logger.Warn("resync failed", zap.String("game_id", g), zap.Error(err))
+			return
+		}
+		h.publish(entity.GameRatingUpdate{GameRating: game, UpdatedAt: time.Now()})
+	}
+}
+
+func (h *Hub) publish(u entity.GameRatingUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.anyGame {
+		h.deliver(sub, u)
+	}
+	for sub := range h.byGame[u.GameId] {
+		h.deliver(sub, u)
+	}
+}
+
+func (h *Hub) deliver(sub *Subscription, u entity.GameRatingUpdate) {
+	switch sub.push(u, h.cfg.MaxPending) {
+	case pushQueued:
+		h.metrics.WatchUpdates.WithLabelValues("queued").Inc()
+	case pushCoalesced:
+		h.metrics.WatchUpdates.WithLabelValues("coalesced").Inc()
+	case pushOverflow:
+		h.metrics.WatchUpdates.WithLabelValues("dropped").Inc()
+		h.logger.Warn("dropping slow subscriber", zap.Int("pending", h.cfg.MaxPending))
+	}
+}
+
+// Subscribe подписывает на игры gameIDs; пустой список - на все игры.
+// Подписку обязательно закрыть через Close.
+func (h *Hub) Subscribe(gameIDs []string) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, errHubStopped
+	}
+	if len(h.subs) >= h.cfg.MaxSubscribers {
+		return nil, &entity.RetryableError{Err: entity.ErrUnavailable, RetryAfter: 5 * time.Second,
+			Reason: "too many watchers"}
+	}
+
+	sub := newSubscription(h, gameIDs, h.cfg.CoalesceInterval)
+	h.subs[sub] = struct{}{}
+	if sub.games == nil {
+		h.anyGame[sub] = struct{}{}
+	}
+	for g := range sub.games {
+		if h.byGame[g] == nil {
+			h.byGame[g] = make(map[*Subscription]struct{})
+		}
+		h.byGame[g][sub] = struct{}{}
+	}
+	h.metrics.WatchSubscribers.Inc()
+
+	return sub, nil
+}
+
+func (h *Hub) unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subs[sub]; !ok {
+		return
+	}
+	delete(h.subs, sub)
+	delete(h.anyGame, sub)
+	for g := range sub.games {
+		delete(h.byGame[g], sub)
+		if len(h.byGame[g]) == 0 {
+			delete(h.byGame, g)
+		}
+	}
+	h.metrics.WatchSubscribers.Dec()
+}
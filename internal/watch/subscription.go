@@ -0,0 +1,146 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/RozmiDan/gameReviewHubRating/internal/entity"
+)
+
+var errSubscriptionClosed = errors.New("watch: subscription closed")
+
+type pushResult int
+
+const (
+	pushIgnored pushResult = iota
+	pushQueued
+	pushCoalesced
+	pushOverflow
+)
+
+// Subscription копит последние обновления по играм до следующего Next.
+type Subscription struct {
+	hub      *Hub
+	games    map[string]struct{}
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending map[string]entity.GameRatingUpdate
+	err     error
+	last    time.Time
+
+	ready chan struct{}
+	done  chan struct{}
+}
+
+func newSubscription(h *Hub, gameIDs []string, interval time.Duration) *Subscription {
+	s := &Subscription{
+		hub:      h,
+		interval: interval,
+		pending:  make(map[string]entity.GameRatingUpdate),
+		ready:    make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	if len(gameIDs) > 0 {
+		s.games = make(map[string]struct{}, len(gameIDs))
+		for _, g := range gameIDs {
+			s.games[g] = struct{}{}
+		}
+	}
+	return s
+}
+
+// push вызывается Hub'ом и никогда не блокируется.
+func (s *Subscription) push(u entity.GameRatingUpdate, maxPending int) pushResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return pushIgnored
+	}
+
+	res := pushQueued
+	if _, ok := s.pending[u.GameId]; ok {
+		res = pushCoalesced
+	} else if len(s.pending) >= maxPending {
+		s.failLocked(entity.ErrSlowConsumer)
+		return pushOverflow
+	}
+	s.pending[u.GameId] = u
+
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+	return res
+}
+
+// Next ждёт обновлений и возвращает их пачкой, по одному на игру. Между пачками
+// выдерживается CoalesceInterval, чтобы частые изменения одной игры схлопывались.
+func (s *Subscription) Next(ctx context.Context) ([]entity.GameRatingUpdate, error) {
+	if wait := s.interval - time.Since(s.last); wait > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.done:
+		case <-time.After(wait):
+		}
+	}
+
+	for {
+		s.mu.Lock()
+		if s.err != nil {
+			err := s.err
+			s.mu.Unlock()
+			return nil, err
+		}
+		if len(s.pending) > 0 {
+			out := make([]entity.GameRatingUpdate, 0, len(s.pending))
+			for _, u := range s.pending {
+				out = append(out, u)
+			}
+			clear(s.pending)
+			s.last = time.Now()
+			s.mu.Unlock()
+
+			sort.Slice(out, func(i, j int) bool { return out[i].GameId < out[j].GameId })
+			return out, nil
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.done:
+		case <-s.ready:
+		}
+	}
+}
+
+// Done закрывается, когда подписка прекращена (медленный клиент, остановка сервера, Close).
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *Subscription) Close() {
+	s.fail(errSubscriptionClosed)
+	s.hub.unsubscribe(s)
+}
+
+func (s *Subscription) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failLocked(err)
+}
+
+func (s *Subscription) failLocked(err error) {
+	if s.err != nil {
+		return
+	}
+	s.err = err
+	clear(s.pending)
+	close(s.done)
+}
@@ -0,0 +1,129 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Component - часть сервиса с управляемым жизненным циклом.
+// Start не блокируется: долгие циклы запускаются в своих горутинах.
+type Component interface {
+	Name() string
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+// Failer - компонент, фоновый цикл которого может упасть после Start.
+type Failer interface {
+	Failed() <-chan error
+}
+
+// Hook - Component из пары функций; любая из них может быть nil.
+type Hook struct {
+	HookName string
+	OnStart  func(ctx context.Context) error
+	OnStop   func(ctx context.Context) error
+}
+
+func (h Hook) Name() string { return h.HookName }
+
+func (h Hook) Start(ctx context.Context) error {
+	if h.OnStart == nil {
+		return nil
+	}
+	return h.OnStart(ctx)
+}
+
+func (h Hook) Stop(ctx context.Context) error {
+	if h.OnStop == nil {
+		return nil
+	}
+	return h.OnStop(ctx)
+}
+
+// Manager запускает компоненты в порядке добавления и останавливает в обратном.
+type Manager struct {
+	components []Component
+	started    []Component
+	failed     chan error
+	logger     *zap.Logger
+}
+
+func New(logger *zap.Logger) *Manager {
+	return &Manager{
+		failed: make(chan error, 1),
+		logger: logger.With(zap.String("component", "lifecycle")),
+	}
+}
+
+func (m *Manager) Add(components ...Component) {
+	m.components = append(m.components, components...)
+}
+
+// Start запускает компоненты по очереди. Если какой-то не стартовал,
+// уже запущенные останавливаются в обратном порядке.
+func (m *Manager) Start(ctx context.Context, stopTimeout time.Duration) error {
+	for _, c := range m.components {
+		m.logger.Info("starting component", zap.String("name", c.Name()))
+		if err := c.Start(ctx); err != nil {
+			startErr := fmt.Errorf("start %s: %w", c.Name(), err)
+			if stopErr := m.Stop(stopTimeout); stopErr != nil {
+				return errors.Join(startErr, stopErr)
+			}
+			return startErr
+		}
+		m.started = append(m.started, c)
+
+		if f, ok := c.(Failer); ok {
+			go m.watch(c.Name(), f)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) watch(name string, f Failer) {
+	err, ok := <-f.Failed()
+	if !ok || err == nil {
+		return
+	}
+	select {
+	case m.failed <- fmt.Errorf("%s failed: %w", name, err):
+	default:
+	}
+}
+
+// Wait блокируется до отмены ctx (сигнал) или падения любого компонента.
+func (m *Manager) Wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		m.logger.Info("shutdown signal received")
+		return nil
+	case err := <-m.failed:
+		m.logger.Error("component failed, shutting down", zap.Error(err))
+		return err
+	}
+}
+
+// Stop останавливает запущенные компоненты в обратном порядке.
+// Все компоненты делят общий дедлайн timeout; ошибки собираются вместе.
+func (m *Manager) Stop(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var errs []error
+	for i := len(m.started) - 1; i >= 0; i-- {
+		c := m.started[i]
+		m.logger.Info("stopping component", zap.String("name", c.Name()))
+		if err := c.Stop(ctx); err != nil {
+			m.logger.Error("component stop failed", zap.String("name", c.Name()), zap.Error(err))
+			errs = append(errs, fmt.Errorf("stop %s: %w", c.Name(), err))
+		}
+	}
+	m.started = nil
+
+	return errors.Join(errs...)
+}
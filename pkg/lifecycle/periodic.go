@@ -0,0 +1,75 @@
+package lifecycle
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Periodic - Component, который вызывает run раз в interval (первый раз - сразу после Start).
+// Ошибка run только логируется: следующий запуск будет по расписанию.
+type Periodic struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+	logger   *zap.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewPeriodic(name string, interval time.Duration, run func(ctx context.Context) error, logger *zap.Logger) *Periodic {
+	return &Periodic{
+		name:     name,
+		interval: interval,
+		run:      run,
+		logger:   logger.With(zap.String("job", name)),
+	}
+}
+
+func (p *Periodic) Name() string { return p.name }
+
+func (p *Periodic) Start(ctx context.Context) error {
+	ctx, p.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	p.done = make(chan struct{})
+
+	go func() {
+		defer close(p.done)
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			start := time.Now()
+			if err := p.run(ctx); err != nil && ctx.Err() == nil {
+				p.logger.Error("job failed", zap.Error(err))
+			} else {
+				p.logger.Debug("job finished", zap.Duration("took", time.Since(start)))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop отменяет текущий запуск и ждёт его завершения.
+func (p *Periodic) Stop(ctx context.Context) error {
+	if p.cancel == nil {
+		return nil
+	}
+	p.cancel()
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
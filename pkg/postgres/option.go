@@ -1,6 +1,10 @@
 package postgres
 
-import "time"
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
 
 // Option -.
 type Option func(*Postgres)
@@ -12,6 +16,27 @@ func MaxPoolSize(size int) Option {
 	}
 }
 
+// MinPoolSize -.
+func MinPoolSize(size int) Option {
+	return func(c *Postgres) {
+		c.minPoolSize = size
+	}
+}
+
+// MaxConnLifetime -.
+func MaxConnLifetime(d time.Duration) Option {
+	return func(c *Postgres) {
+		c.maxConnLifetime = d
+	}
+}
+
+// MaxConnIdleTime -.
+func MaxConnIdleTime(d time.Duration) Option {
+	return func(c *Postgres) {
+		c.maxConnIdleTime = d
+	}
+}
+
 // ConnAttempts -.
 func ConnAttempts(attempts int) Option {
 	return func(c *Postgres) {
@@ -25,3 +50,10 @@ func ConnTimeout(timeout time.Duration) Option {
 		c.connTimeout = timeout
 	}
 }
+
+// QueryTracer -.
+func QueryTracer(tracer pgx.QueryTracer) Option {
+	return func(c *Postgres) {
+		c.tracer = tracer
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -17,9 +18,13 @@ const (
 
 // Postgres -.
 type Postgres struct {
-	maxPoolSize  int
-	connAttempts int
-	connTimeout  time.Duration
+	maxPoolSize     int
+	minPoolSize     int
+	maxConnLifetime time.Duration
+	maxConnIdleTime time.Duration
+	connAttempts    int
+	connTimeout     time.Duration
+	tracer          pgx.QueryTracer
 
 	// Builder squirrel.StatementBuilderType
 	Pool *pgxpool.Pool
@@ -46,6 +51,16 @@ func New(url string, opts ...Option) (*Postgres, error) {
 	}
 
 	poolConfig.MaxConns = int32(pg.maxPoolSize) //nolint:gosec // skip integer overflow conversion int -> int32
+	poolConfig.MinConns = int32(pg.minPoolSize) //nolint:gosec // skip integer overflow conversion int -> int32
+	if pg.maxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = pg.maxConnLifetime
+	}
+	if pg.maxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = pg.maxConnIdleTime
+	}
+	if pg.tracer != nil {
+		poolConfig.ConnConfig.Tracer = pg.tracer
+	}
 
 	for pg.connAttempts > 0 {
 		pg.Pool, err = pgxpool.NewWithConfig(context.Background(), poolConfig)